@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -14,10 +15,10 @@ type AuthenticationBindings struct {
 	DockerAuthenticationFlowAlias string `json:"dockerAuthenticationFlow"`
 }
 
-func (keycloakClient *KeycloakClient) GetAuthenticationBindings(realmId string) (*AuthenticationBindings, error) {
+func (keycloakClient *KeycloakClient) GetAuthenticationBindings(ctx context.Context, realmId string) (*AuthenticationBindings, error) {
 	var result AuthenticationBindings
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s", realmId), &result, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s", realmId), &result, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -26,9 +27,9 @@ func (keycloakClient *KeycloakClient) GetAuthenticationBindings(realmId string)
 	return &result, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateAuthenticationBindings(authenticationBindings *AuthenticationBindings) error {
+func (keycloakClient *KeycloakClient) UpdateAuthenticationBindings(ctx context.Context, authenticationBindings *AuthenticationBindings) error {
 
-	realm, err := keycloakClient.GetRealm(authenticationBindings.RealmId)
+	realm, err := keycloakClient.GetRealm(ctx, authenticationBindings.RealmId)
 	if err != nil {
 		return err
 	}
@@ -40,7 +41,7 @@ func (keycloakClient *KeycloakClient) UpdateAuthenticationBindings(authenticatio
 	realm.ClientAuthenticationFlow = authenticationBindings.ClientAuthenticationFlowAlias
 	realm.DockerAuthenticationFlow = authenticationBindings.DockerAuthenticationFlowAlias
 
-	err = keycloakClient.UpdateRealm(realm)
+	err = keycloakClient.UpdateRealm(ctx, realm)
 	if err != nil {
 		return err
 	}
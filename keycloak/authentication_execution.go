@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -64,10 +65,10 @@ func (list AuthenticationExecutionList) Swap(i, j int) {
 	list[i], list[j] = list[j], list[i]
 }
 
-func (keycloakClient *KeycloakClient) ListAuthenticationExecutions(realmId, parentFlowAlias string) (AuthenticationExecutionList, error) {
+func (keycloakClient *KeycloakClient) ListAuthenticationExecutions(ctx context.Context, realmId, parentFlowAlias string) (AuthenticationExecutionList, error) {
 	var authenticationExecutions []*AuthenticationExecutionInfo
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -75,11 +76,11 @@ func (keycloakClient *KeycloakClient) ListAuthenticationExecutions(realmId, pare
 	return authenticationExecutions, err
 }
 
-func (keycloakClient *KeycloakClient) GetAuthenticationExecutionInfoFromProviderId(realmId, parentFlowAlias, providerId string) (*AuthenticationExecutionInfo, error) {
+func (keycloakClient *KeycloakClient) GetAuthenticationExecutionInfoFromProviderId(ctx context.Context, realmId, parentFlowAlias, providerId string) (*AuthenticationExecutionInfo, error) {
 	var authenticationExecutions []*AuthenticationExecutionInfo
 	var authenticationExecution AuthenticationExecutionInfo
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +88,7 @@ func (keycloakClient *KeycloakClient) GetAuthenticationExecutionInfoFromProvider
 	// Retry 3 more times if not found, sometimes it took split milliseconds the Authentication Executions to populate
 	if len(authenticationExecutions) == 0 {
 		for i := 0; i < 3; i++ {
-			err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
+			err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
 
 			if len(authenticationExecutions) > 0 {
 				break
@@ -118,15 +119,15 @@ func (keycloakClient *KeycloakClient) GetAuthenticationExecutionInfoFromProvider
 	return nil, fmt.Errorf("no authentication execution under parent flow alias %s with provider id %s found", parentFlowAlias, providerId)
 }
 
-func (keycloakClient *KeycloakClient) NewAuthenticationExecution(execution *AuthenticationExecution) error {
-	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/authentication/flows/%s/executions/execution", execution.RealmId, execution.ParentFlowAlias), &authenticationExecutionCreate{Provider: execution.Authenticator})
+func (keycloakClient *KeycloakClient) NewAuthenticationExecution(ctx context.Context, execution *AuthenticationExecution) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions/execution", execution.RealmId, execution.ParentFlowAlias), &authenticationExecutionCreate{Provider: execution.Authenticator})
 	if err != nil {
 		return err
 	}
 
 	execution.Id = getIdFromLocationHeader(location)
 
-	err = keycloakClient.UpdateAuthenticationExecution(execution)
+	err = keycloakClient.UpdateAuthenticationExecution(ctx, execution)
 	if err != nil {
 		return err
 	}
@@ -134,10 +135,10 @@ func (keycloakClient *KeycloakClient) NewAuthenticationExecution(execution *Auth
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetAuthenticationExecution(realmId, parentFlowAlias, id string) (*AuthenticationExecution, error) {
+func (keycloakClient *KeycloakClient) GetAuthenticationExecution(ctx context.Context, realmId, parentFlowAlias, id string) (*AuthenticationExecution, error) {
 	var authenticationExecution AuthenticationExecution
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), &authenticationExecution, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), &authenticationExecution, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -148,71 +149,71 @@ func (keycloakClient *KeycloakClient) GetAuthenticationExecution(realmId, parent
 	return &authenticationExecution, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateAuthenticationExecution(execution *AuthenticationExecution) error {
+func (keycloakClient *KeycloakClient) UpdateAuthenticationExecution(ctx context.Context, execution *AuthenticationExecution) error {
 	authenticationExecutionUpdateRequirement := &authenticationExecutionRequirementUpdate{
 		RealmId:         execution.RealmId,
 		ParentFlowAlias: execution.ParentFlowAlias,
 		Id:              execution.Id,
 		Requirement:     execution.Requirement,
 	}
-	return keycloakClient.UpdateAuthenticationExecutionRequirement(authenticationExecutionUpdateRequirement)
+	return keycloakClient.UpdateAuthenticationExecutionRequirement(ctx, authenticationExecutionUpdateRequirement)
 }
 
-func (keycloakClient *KeycloakClient) UpdateAuthenticationExecutionRequirement(executionRequirementUpdate *authenticationExecutionRequirementUpdate) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", executionRequirementUpdate.RealmId, executionRequirementUpdate.ParentFlowAlias), executionRequirementUpdate)
+func (keycloakClient *KeycloakClient) UpdateAuthenticationExecutionRequirement(ctx context.Context, executionRequirementUpdate *authenticationExecutionRequirementUpdate) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", executionRequirementUpdate.RealmId, executionRequirementUpdate.ParentFlowAlias), executionRequirementUpdate)
 }
 
-func (keycloakClient *KeycloakClient) disableBuiltInPropertyInParentFlow(realmId, flowId string) {
-	flow, err := keycloakClient.GetAuthenticationFlow(realmId, flowId)
+func (keycloakClient *KeycloakClient) disableBuiltInPropertyInParentFlow(ctx context.Context, realmId, flowId string) {
+	flow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, flowId)
 	if err == nil && flow.BuiltIn {
 		flow.BuiltIn = false
-		err = keycloakClient.UpdateAuthenticationFlow(flow)
+		err = keycloakClient.UpdateAuthenticationFlow(ctx, flow)
 		if err == nil {
 			log.Println("Disable built in flow", flow.Alias)
 		}
 	}
 }
 
-func (keycloakClient *KeycloakClient) DeleteAuthenticationExecution(realmId, id string) error {
-	exe, err := keycloakClient.GetAuthenticationExecution(realmId, "invalid-parent-flow-alias", id)
+func (keycloakClient *KeycloakClient) DeleteAuthenticationExecution(ctx context.Context, realmId, id string) error {
+	exe, err := keycloakClient.GetAuthenticationExecution(ctx, realmId, "invalid-parent-flow-alias", id)
 	if err == nil {
-		keycloakClient.disableBuiltInPropertyInParentFlow(realmId, exe.ParentFlowId)
+		keycloakClient.disableBuiltInPropertyInParentFlow(ctx, realmId, exe.ParentFlowId)
 	}
-	err = keycloakClient.delete(fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), nil)
+	err = keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), nil)
 	if err != nil {
 		// For whatever reason, this fails sometimes with a 500 during acceptance tests. try again
-		return keycloakClient.delete(fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), nil)
+		return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), nil)
 	}
 
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) DeleteDefaultDefaultClientScope(realmId string, id string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/default-default-client-scopes/%s", realmId, id), nil)
+func (keycloakClient *KeycloakClient) DeleteDefaultDefaultClientScope(ctx context.Context, realmId string, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/default-default-client-scopes/%s", realmId, id), nil)
 }
 
-func (keycloakClient *KeycloakClient) AddDefaultDefaultClientScope(realmId string, dcs DefaultClientScope) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/default-default-client-scopes/%s", realmId, dcs.Id), dcs)
+func (keycloakClient *KeycloakClient) AddDefaultDefaultClientScope(ctx context.Context, realmId string, dcs DefaultClientScope) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/default-default-client-scopes/%s", realmId, dcs.Id), dcs)
 }
 
-func (keycloakClient *KeycloakClient) DeleteOptionalDefaultClientScope(realmId string, id string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/default-optional-client-scopes/%s", realmId, id), nil)
+func (keycloakClient *KeycloakClient) DeleteOptionalDefaultClientScope(ctx context.Context, realmId string, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/default-optional-client-scopes/%s", realmId, id), nil)
 }
 
-func (keycloakClient *KeycloakClient) AddOptionalDefaultClientScope(realmId string, dcs DefaultClientScope) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/default-optional-client-scopes/%s", realmId, dcs.Id), dcs)
+func (keycloakClient *KeycloakClient) AddOptionalDefaultClientScope(ctx context.Context, realmId string, dcs DefaultClientScope) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/default-optional-client-scopes/%s", realmId, dcs.Id), dcs)
 }
 
-func (keycloakClient *KeycloakClient) RaiseAuthenticationExecutionPriority(realmId, id string) error {
-	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/authentication/executions/%s/raise-priority", realmId, id), nil)
+func (keycloakClient *KeycloakClient) RaiseAuthenticationExecutionPriority(ctx context.Context, realmId, id string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s/raise-priority", realmId, id), nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) LowerAuthenticationExecutionPriority(realmId, id string) error {
-	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/authentication/executions/%s/lower-priority", realmId, id), nil)
+func (keycloakClient *KeycloakClient) LowerAuthenticationExecutionPriority(ctx context.Context, realmId, id string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s/lower-priority", realmId, id), nil)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,7 @@
 package keycloak
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -17,10 +17,10 @@ type AuthenticationFlow struct {
 	BuiltIn     bool   `json:"builtIn"`
 }
 
-func (keycloakClient *KeycloakClient) ListAuthenticationFlows(realmId string) ([]*AuthenticationFlow, error) {
+func (keycloakClient *KeycloakClient) ListAuthenticationFlows(ctx context.Context, realmId string) ([]*AuthenticationFlow, error) {
 	var authenticationFlows []*AuthenticationFlow
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows", realmId), &authenticationFlows, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows", realmId), &authenticationFlows, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -32,11 +32,11 @@ func (keycloakClient *KeycloakClient) ListAuthenticationFlows(realmId string) ([
 	return authenticationFlows, nil
 }
 
-func (keycloakClient *KeycloakClient) NewAuthenticationFlow(authenticationFlow *AuthenticationFlow) error {
+func (keycloakClient *KeycloakClient) NewAuthenticationFlow(ctx context.Context, authenticationFlow *AuthenticationFlow) error {
 	authenticationFlow.TopLevel = true
 	authenticationFlow.BuiltIn = false
 
-	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/authentication/flows", authenticationFlow.RealmId), authenticationFlow)
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows", authenticationFlow.RealmId), authenticationFlow)
 	if err != nil {
 		return err
 	}
@@ -45,9 +45,9 @@ func (keycloakClient *KeycloakClient) NewAuthenticationFlow(authenticationFlow *
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetAuthenticationFlow(realmId, id string) (*AuthenticationFlow, error) {
+func (keycloakClient *KeycloakClient) GetAuthenticationFlow(ctx context.Context, realmId, id string) (*AuthenticationFlow, error) {
 	var authenticationFlow AuthenticationFlow
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), &authenticationFlow, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), &authenticationFlow, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -56,11 +56,11 @@ func (keycloakClient *KeycloakClient) GetAuthenticationFlow(realmId, id string)
 	return &authenticationFlow, nil
 }
 
-func (keycloakClient *KeycloakClient) GetAuthenticationFlowFromAlias(realmId, alias string) (*AuthenticationFlow, error) {
+func (keycloakClient *KeycloakClient) GetAuthenticationFlowFromAlias(ctx context.Context, realmId, alias string) (*AuthenticationFlow, error) {
 	var authenticationFlows []*AuthenticationFlow
 	var authenticationFlow *AuthenticationFlow = nil
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows", realmId), &authenticationFlows, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows", realmId), &authenticationFlows, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +68,7 @@ func (keycloakClient *KeycloakClient) GetAuthenticationFlowFromAlias(realmId, al
 	// Retry 3 more times if not found, sometimes it took split milliseconds the Authentication to populate
 	if len(authenticationFlows) == 0 {
 		for i := 0; i < 3; i++ {
-			err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows", realmId), &authenticationFlows, nil)
+			err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows", realmId), &authenticationFlows, nil)
 
 			if len(authenticationFlows) > 0 {
 				break
@@ -100,59 +100,59 @@ func (keycloakClient *KeycloakClient) GetAuthenticationFlowFromAlias(realmId, al
 	return authenticationFlow, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateAuthenticationFlow(authenticationFlow *AuthenticationFlow) error {
+func (keycloakClient *KeycloakClient) UpdateAuthenticationFlow(ctx context.Context, authenticationFlow *AuthenticationFlow) error {
 	authenticationFlow.TopLevel = true
 	authenticationFlow.BuiltIn = false
 
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/authentication/flows/%s", authenticationFlow.RealmId, authenticationFlow.Id), authenticationFlow)
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", authenticationFlow.RealmId, authenticationFlow.Id), authenticationFlow)
 }
 
-func (keycloakClient *KeycloakClient) DeleteAuthenticationFlow(realmId, id string) error {
+func (keycloakClient *KeycloakClient) DeleteAuthenticationFlow(ctx context.Context, realmId, id string) error {
 
-	flow, err := keycloakClient.GetAuthenticationFlow(realmId, id)
+	flow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, id)
 	if err == nil && flow.BuiltIn {
 		log.Println("Disable built in flow", flow.Alias)
 		flow.BuiltIn = false
-		keycloakClient.UpdateAuthenticationFlow(flow)
+		keycloakClient.UpdateAuthenticationFlow(ctx, flow)
 	}
 
-	err = keycloakClient.delete(fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), nil)
+	err = keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), nil)
 	if err != nil {
 		// For whatever reason, this fails sometimes with a 500 during acceptance tests. try again
-		return keycloakClient.delete(fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), nil)
+		return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), nil)
 	}
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) DeleteBuiltInFlowExecutors(flow *AuthenticationFlow) error {
+func (keycloakClient *KeycloakClient) DeleteBuiltInFlowExecutors(ctx context.Context, flow *AuthenticationFlow) error {
 	flow.BuiltIn = false
-	err := keycloakClient.UpdateAuthenticationFlow(flow)
+	err := keycloakClient.UpdateAuthenticationFlow(ctx, flow)
 	if err != nil {
 		return err
 	}
-	executors, err := keycloakClient.ListAuthenticationExecutions(flow.RealmId, flow.Alias)
+	executors, err := keycloakClient.ListAuthenticationExecutions(ctx, flow.RealmId, flow.Alias)
 	if err != nil {
 		return err
 	}
 	for _, exe := range executors {
 		if len(exe.FlowId) > 0 {
 			var subFlow AuthenticationSubFlow
-			err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows/%s", flow.RealmId, exe.FlowId), &subFlow, nil)
+			err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", flow.RealmId, exe.FlowId), &subFlow, nil)
 			if err != nil {
 				return err
 			}
 			subFlow.RealmId = flow.RealmId
 			subFlow.BuiltIn = false
-			err = keycloakClient.put(fmt.Sprintf("/realms/%s/authentication/flows/%s", subFlow.RealmId, subFlow.Id), subFlow)
+			err = keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", subFlow.RealmId, subFlow.Id), subFlow)
 			if err != nil {
 				return err
 			}
-			err = keycloakClient.DeleteAuthenticationExecution(flow.RealmId, exe.Id)
+			err = keycloakClient.DeleteAuthenticationExecution(ctx, flow.RealmId, exe.Id)
 			if err != nil && !isHttpError(err, 404) { // resource may be deleted with a subflow already
 				return err
 			}
 		} else {
-			err = keycloakClient.DeleteAuthenticationExecution(flow.RealmId, exe.Id)
+			err = keycloakClient.DeleteAuthenticationExecution(ctx, flow.RealmId, exe.Id)
 			if err != nil && !isHttpError(err, 404) { // resource may be deleted with a subflow already
 				return err
 			}
@@ -160,14 +160,3 @@ func (keycloakClient *KeycloakClient) DeleteBuiltInFlowExecutors(flow *Authentic
 	}
 	return nil
 }
-
-func isHttpError(err error, code int) bool {
-	var apiError *ApiError
-	if !errors.As(err, &apiError) {
-		return false
-	}
-	if apiError.Code != code {
-		return false
-	}
-	return true
-}
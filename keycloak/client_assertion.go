@@ -0,0 +1,209 @@
+package keycloak
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+)
+
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// Signed client assertions are only used once, for a single token request
+const clientAssertionLifetime = time.Minute
+
+// ClientAssertion configures authentication of the provider's client with a signed JWT (private_key_jwt) instead of
+// a client secret. The client needs to be configured with the "Signed JWT" client authenticator in Keycloak.
+type ClientAssertion struct {
+	// PEM encoded private key, or a path to a file containing it
+	PrivateKey string
+	// Optional key id, which is sent as the "kid" header of the assertion
+	KeyId string
+	// One of RS256, RS384, RS512, PS256, PS384, PS512, ES256, ES384 or ES512. Defaults to RS256
+	Algorithm string
+}
+
+type clientAssertionSigner struct {
+	privateKey crypto.Signer
+	keyId      string
+	algorithm  string
+	hash       crypto.Hash
+}
+
+var clientAssertionHashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"PS256": crypto.SHA256,
+	"PS384": crypto.SHA384,
+	"PS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+	"ES512": crypto.SHA512,
+}
+
+var clientAssertionCurves = map[string]elliptic.Curve{
+	"ES256": elliptic.P256(),
+	"ES384": elliptic.P384(),
+	"ES512": elliptic.P521(),
+}
+
+func parsePrivateKey(privateKeyPem []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(privateKeyPem)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded private key found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		switch key := privateKey.(type) {
+		case *rsa.PrivateKey:
+			return key, nil
+		case *ecdsa.PrivateKey:
+			return key, nil
+		}
+
+		return nil, fmt.Errorf("unsupported private key type %T, expected an RSA or EC private key", privateKey)
+	}
+
+	return nil, fmt.Errorf("unsupported PEM block type %s, expected a private key", block.Type)
+}
+
+func newClientAssertionSigner(clientAssertion ClientAssertion) (*clientAssertionSigner, error) {
+	if clientAssertion.PrivateKey == "" {
+		return nil, nil
+	}
+
+	algorithm := clientAssertion.Algorithm
+	if algorithm == "" {
+		algorithm = "RS256"
+	}
+
+	hash, ok := clientAssertionHashes[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unsupported client assertion algorithm %s", algorithm)
+	}
+
+	privateKeyPem, err := readPemOrFile(clientAssertion.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error reading client assertion private key: %s", err)
+	}
+
+	privateKey, err := parsePrivateKey(privateKeyPem)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing client assertion private key: %s", err)
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if algorithm[0] == 'E' {
+			return nil, fmt.Errorf("client assertion algorithm %s requires an EC private key, got an RSA private key", algorithm)
+		}
+	case *ecdsa.PrivateKey:
+		if curve := clientAssertionCurves[algorithm]; curve == nil || curve != key.Curve {
+			return nil, fmt.Errorf("client assertion algorithm %s can't be used with an EC private key on curve %s", algorithm, key.Curve.Params().Name)
+		}
+	}
+
+	return &clientAssertionSigner{
+		privateKey: privateKey,
+		keyId:      clientAssertion.KeyId,
+		algorithm:  algorithm,
+		hash:       hash,
+	}, nil
+}
+
+// Builds and signs a JWT that authenticates the given client at the given token endpoint, see
+// https://tools.ietf.org/html/rfc7523#section-2.2
+func (signer *clientAssertionSigner) sign(clientId, audience string) (string, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+
+	header := map[string]string{
+		"alg": signer.algorithm,
+		"typ": "JWT",
+	}
+	if signer.keyId != "" {
+		header["kid"] = signer.keyId
+	}
+
+	claims := map[string]interface{}{
+		"iss": clientId,
+		"sub": clientId,
+		"aud": audience,
+		"jti": hex.EncodeToString(jti),
+		"iat": now.Unix(),
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+	}
+
+	headerJson, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+
+	claimsJson, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJson) + "." + base64.RawURLEncoding.EncodeToString(claimsJson)
+
+	digest := signer.hash.New()
+	digest.Write([]byte(signingInput))
+
+	signature, err := signer.signDigest(digest.Sum(nil))
+	if err != nil {
+		return "", fmt.Errorf("error signing client assertion: %s", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (signer *clientAssertionSigner) signDigest(digest []byte) ([]byte, error) {
+	switch key := signer.privateKey.(type) {
+	case *rsa.PrivateKey:
+		if signer.algorithm[0] == 'P' {
+			return rsa.SignPSS(rand.Reader, key, signer.hash, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+
+		return rsa.SignPKCS1v15(rand.Reader, key, signer.hash, digest)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		// JWS uses the fixed size concatenation of r and s instead of the ASN.1 encoding used by Go
+		size := (key.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+
+		return signature, nil
+	}
+
+	return nil, fmt.Errorf("unsupported private key type %T", signer.privateKey)
+}
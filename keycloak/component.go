@@ -1,6 +1,9 @@
 package keycloak
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // https://www.keycloak.org/docs-api/4.2/rest-api/index.html#_component_resource
 
@@ -29,26 +32,26 @@ func (component *Component) getConfigOk(val string) (string, bool) {
 	return "", false
 }
 
-func (keycloakClient *KeycloakClient) GetComponents(realm, parent, providerType string) ([]Component, error) {
+func (keycloakClient *KeycloakClient) GetComponents(ctx context.Context, realm, parent, providerType string) ([]Component, error) {
 	result := []Component{}
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components?parent=%s&type=%s", realm, parent, providerType), &result, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components?parent=%s&type=%s", realm, parent, providerType), &result, nil)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-func (keycloakClient *KeycloakClient) GetComponent(realm, id string) (*Component, error) {
+func (keycloakClient *KeycloakClient) GetComponent(ctx context.Context, realm, id string) (*Component, error) {
 	var result Component
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components/%s", realm, id), &result, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realm, id), &result, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (keycloakClient *KeycloakClient) CreateComponent(realm string, component Component) error {
-	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/components", realm), component)
+func (keycloakClient *KeycloakClient) CreateComponent(ctx context.Context, realm string, component Component) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", realm), component)
 	if err != nil {
 		return err
 	}
@@ -56,10 +59,10 @@ func (keycloakClient *KeycloakClient) CreateComponent(realm string, component Co
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateComponent(realm string, component Component) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/components/%s", realm, component.Id), component)
+func (keycloakClient *KeycloakClient) UpdateComponent(ctx context.Context, realm string, component Component) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", realm, component.Id), component)
 }
 
-func (keycloakClient *KeycloakClient) DeleteComponent(realmId, id string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+func (keycloakClient *KeycloakClient) DeleteComponent(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
 }
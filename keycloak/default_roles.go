@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -9,10 +10,10 @@ type DefaultRoles struct {
 	DefaultRoles []string `json:"defaultRoles,omitempty"`
 }
 
-func (keycloakClient *KeycloakClient) GetDefaultRoles(realmId string) (*DefaultRoles, error) {
+func (keycloakClient *KeycloakClient) GetDefaultRoles(ctx context.Context, realmId string) (*DefaultRoles, error) {
 	var result DefaultRoles
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s", realmId), &result, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s", realmId), &result, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -21,16 +22,16 @@ func (keycloakClient *KeycloakClient) GetDefaultRoles(realmId string) (*DefaultR
 	return &result, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateDefaultRoles(defaultRoles *DefaultRoles) error {
+func (keycloakClient *KeycloakClient) UpdateDefaultRoles(ctx context.Context, defaultRoles *DefaultRoles) error {
 
-	realm, err := keycloakClient.GetRealm(defaultRoles.RealmId)
+	realm, err := keycloakClient.GetRealm(ctx, defaultRoles.RealmId)
 	if err != nil {
 		return err
 	}
 
 	realm.DefaultRoles = defaultRoles.DefaultRoles
 
-	err = keycloakClient.UpdateRealm(realm)
+	err = keycloakClient.UpdateRealm(ctx, realm)
 	if err != nil {
 		return err
 	}
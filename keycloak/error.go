@@ -1,27 +1,197 @@
 package keycloak
 
 import (
-	"github.com/hashicorp/errwrap"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
+// ApiError is returned when Keycloak responds with an error status. Besides the status code, it holds the request that
+// failed and whatever Keycloak said about the error, if the response body is one of its JSON error representations.
 type ApiError struct {
 	Code    int
 	Message string
+
+	Method string
+	Path   string
+
+	// OAuth style error code, such as "invalid_grant" or "unknown_error"
+	ErrorCode string
+	// Human readable message, such as "Client foo already exists"
+	ErrorMessage     string
+	ErrorDescription string
+	// Newer versions of Keycloak report which attributes of the representation were rejected
+	FieldErrors []FieldError
+}
+
+type FieldError struct {
+	Field        string
+	ErrorMessage string
+	Params       []interface{}
 }
 
 func (e *ApiError) Error() string {
 	return e.Message
 }
 
-func ErrorIs404(err error) bool {
-	keycloakError, ok := errwrap.GetType(err, &ApiError{}).(*ApiError)
+// Keycloak uses a different error representation depending on the endpoint and its version
+type errorRepresentation struct {
+	Error            string                `json:"error"`
+	ErrorDescription string                `json:"error_description"`
+	ErrorMessage     string                `json:"errorMessage"`
+	Field            string                `json:"field"`
+	Params           []interface{}         `json:"params"`
+	Errors           []errorRepresentation `json:"errors"`
+}
+
+func newApiError(method, path string, code int, status string, body []byte) *ApiError {
+	apiError := &ApiError{
+		Code:   code,
+		Method: method,
+		Path:   path,
+	}
+
+	var representation errorRepresentation
+	if len(body) != 0 && json.Unmarshal(body, &representation) == nil {
+		apiError.ErrorCode = representation.Error
+		apiError.ErrorMessage = representation.ErrorMessage
+		apiError.ErrorDescription = representation.ErrorDescription
+
+		for _, fieldError := range append([]errorRepresentation{representation}, representation.Errors...) {
+			if fieldError.Field != "" {
+				apiError.FieldErrors = append(apiError.FieldErrors, FieldError{
+					Field:        fieldError.Field,
+					ErrorMessage: fieldError.ErrorMessage,
+					Params:       fieldError.Params,
+				})
+			}
+		}
+	}
+
+	apiError.Message = fmt.Sprintf("error sending %s request to %s: %s.", method, path, status)
+	if details := apiError.details(); details != "" {
+		apiError.Message = fmt.Sprintf("%s %s", apiError.Message, details)
+	} else if len(body) != 0 {
+		apiError.Message = fmt.Sprintf("%s Response body: %s", apiError.Message, redactJson(body))
+	}
+
+	return apiError
+}
 
-	return ok && keycloakError != nil && keycloakError.Code == http.StatusNotFound
+// Returns what Keycloak said about the error, ex: "invalid_grant: Invalid user credentials"
+func (e *ApiError) details() string {
+	var details []string
+
+	if e.ErrorCode != "" && e.ErrorDescription != "" {
+		details = append(details, fmt.Sprintf("%s: %s", e.ErrorCode, e.ErrorDescription))
+	} else if e.ErrorCode != "" && e.ErrorMessage == "" {
+		details = append(details, e.ErrorCode)
+	} else if e.ErrorDescription != "" {
+		details = append(details, e.ErrorDescription)
+	}
+
+	if e.ErrorMessage != "" && len(e.FieldErrors) == 0 {
+		details = append(details, e.ErrorMessage)
+	}
+
+	for _, fieldError := range e.FieldErrors {
+		details = append(details, fmt.Sprintf("%s: %s", fieldError.Field, fieldError.ErrorMessage))
+	}
+
+	return strings.Join(details, ", ")
+}
+
+// Returns an error like the one Keycloak responds with when a resource doesn't exist, for resources that are looked up
+// in a list rather than requested directly
+func newNotFoundError(path, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+
+	return &ApiError{
+		Code:         http.StatusNotFound,
+		Message:      message,
+		Method:       http.MethodGet,
+		Path:         path,
+		ErrorMessage: message,
+	}
+}
+
+func asApiError(err error) (*ApiError, bool) {
+	var apiError *ApiError
+	if !errors.As(err, &apiError) || apiError == nil {
+		return nil, false
+	}
+
+	return apiError, true
+}
+
+func isHttpError(err error, code int) bool {
+	apiError, ok := asApiError(err)
+
+	return ok && apiError.Code == code
+}
+
+// LoginError is returned when a request fails because the client couldn't log in or refresh its tokens. It doesn't
+// unwrap to the error of the token request, as a 404 or 403 from the token endpoint means that the provider is
+// misconfigured, not that the resource the request was for doesn't exist or can't be accessed. Err holds the cause.
+type LoginError struct {
+	Message string
+	Err     error
+}
+
+func (e *LoginError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Message, e.Err)
+}
+
+// ValidationError is returned when a resource is rejected before it is sent to Keycloak. Field is the name of the struct
+// field that caused the error, which lets the provider point at the matching attribute.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return "validation error: " + e.Message
+}
+
+func newValidationError(field, format string, args ...interface{}) error {
+	return &ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func ErrorIs400(err error) bool {
+	return isHttpError(err, http.StatusBadRequest)
+}
+
+func ErrorIsForbidden(err error) bool {
+	return isHttpError(err, http.StatusForbidden)
+}
+
+func ErrorIs404(err error) bool {
+	return isHttpError(err, http.StatusNotFound)
 }
 
 func ErrorIs409(err error) bool {
-	keycloakError, ok := errwrap.GetType(err, &ApiError{}).(*ApiError)
+	return isHttpError(err, http.StatusConflict)
+}
+
+// Returns true if Keycloak rejected the request because another resource already uses the same value for the given
+// field. Newer versions of Keycloak name the field, older ones only mention it in the message, ex: "User exists with
+// same username".
+func IsConflictOnField(err error, field string) bool {
+	apiError, ok := asApiError(err)
+	if !ok || apiError.Code != http.StatusConflict {
+		return false
+	}
+
+	for _, fieldError := range apiError.FieldErrors {
+		if strings.EqualFold(fieldError.Field, field) {
+			return true
+		}
+	}
 
-	return ok && keycloakError != nil && keycloakError.Code == http.StatusConflict
+	return strings.Contains(strings.ToLower(apiError.ErrorMessage), strings.ToLower(field))
 }
@@ -0,0 +1,127 @@
+package keycloak
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestApiErrorParsesErrorMessage(t *testing.T) {
+	apiError := newApiError(http.MethodPost, "/auth/admin/realms/test/clients", http.StatusConflict, "409 Conflict", []byte(`{"errorMessage": "Client foo already exists"}`))
+
+	if apiError.ErrorMessage != "Client foo already exists" {
+		t.Errorf("expected error message to be parsed, got %s", apiError.ErrorMessage)
+	}
+	if apiError.Method != http.MethodPost || apiError.Path != "/auth/admin/realms/test/clients" {
+		t.Errorf("expected request to be recorded, got %s %s", apiError.Method, apiError.Path)
+	}
+
+	expected := "error sending POST request to /auth/admin/realms/test/clients: 409 Conflict. Client foo already exists"
+	if apiError.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, apiError.Error())
+	}
+}
+
+func TestApiErrorParsesOauthError(t *testing.T) {
+	apiError := newApiError(http.MethodPost, "/auth/realms/master/protocol/openid-connect/token", http.StatusUnauthorized, "401 Unauthorized", []byte(`{"error": "invalid_grant", "error_description": "Invalid user credentials"}`))
+
+	if apiError.ErrorCode != "invalid_grant" || apiError.ErrorDescription != "Invalid user credentials" {
+		t.Errorf("expected oauth error to be parsed, got %s: %s", apiError.ErrorCode, apiError.ErrorDescription)
+	}
+	if !strings.HasSuffix(apiError.Error(), "invalid_grant: Invalid user credentials") {
+		t.Errorf("expected error and description in message, got %s", apiError.Error())
+	}
+
+	apiError = newApiError(http.MethodPut, "/auth/admin/realms/test", http.StatusBadRequest, "400 Bad Request", []byte(`{"error": "unknown_error"}`))
+	if !strings.HasSuffix(apiError.Error(), "400 Bad Request. unknown_error") {
+		t.Errorf("expected error code in message, got %s", apiError.Error())
+	}
+}
+
+func TestApiErrorParsesFieldErrors(t *testing.T) {
+	apiError := newApiError(http.MethodPost, "/auth/admin/realms/test/users", http.StatusBadRequest, "400 Bad Request", []byte(`{
+		"field": "username",
+		"errorMessage": "error-invalid-length",
+		"params": ["username", 3, 255],
+		"errors": [
+			{"field": "email", "errorMessage": "invalidEmailMessage", "params": ["email"]}
+		]
+	}`))
+
+	if len(apiError.FieldErrors) != 2 {
+		t.Fatalf("expected two field errors, got %v", apiError.FieldErrors)
+	}
+	if apiError.FieldErrors[0].Field != "username" || apiError.FieldErrors[1].Field != "email" {
+		t.Errorf("expected field errors for username and email, got %v", apiError.FieldErrors)
+	}
+	if !strings.HasSuffix(apiError.Error(), "username: error-invalid-length, email: invalidEmailMessage") {
+		t.Errorf("expected field errors in message, got %s", apiError.Error())
+	}
+}
+
+func TestApiErrorKeepsUnparsableBody(t *testing.T) {
+	apiError := newApiError(http.MethodGet, "/auth/admin/realms/test", http.StatusBadGateway, "502 Bad Gateway", []byte(`<html>Bad Gateway</html>`))
+
+	if !strings.HasSuffix(apiError.Error(), "Response body: <html>Bad Gateway</html>") {
+		t.Errorf("expected raw body in message, got %s", apiError.Error())
+	}
+
+	apiError = newApiError(http.MethodGet, "/auth/admin/realms/test", http.StatusNotFound, "404 Not Found", nil)
+	if apiError.Error() != "error sending GET request to /auth/admin/realms/test: 404 Not Found." {
+		t.Errorf("unexpected message %s", apiError.Error())
+	}
+}
+
+func TestApiErrorHelpers(t *testing.T) {
+	wrap := func(code int, body string) error {
+		return fmt.Errorf("error creating user: %w", newApiError(http.MethodPost, "/", code, http.StatusText(code), []byte(body)))
+	}
+
+	if !ErrorIs400(wrap(http.StatusBadRequest, "")) || ErrorIs400(wrap(http.StatusNotFound, "")) {
+		t.Error("expected ErrorIs400 to only match 400 responses")
+	}
+	if !ErrorIsForbidden(wrap(http.StatusForbidden, "")) || ErrorIsForbidden(wrap(http.StatusUnauthorized, "")) {
+		t.Error("expected ErrorIsForbidden to only match 403 responses")
+	}
+	if !ErrorIs404(wrap(http.StatusNotFound, "")) || !ErrorIs409(wrap(http.StatusConflict, "")) {
+		t.Error("expected wrapped errors to be matched")
+	}
+	if ErrorIs404(fmt.Errorf("not an api error")) || ErrorIs404(nil) {
+		t.Error("expected other errors not to be matched")
+	}
+
+	if !IsConflictOnField(wrap(http.StatusConflict, `{"errorMessage": "User exists with same username"}`), "username") {
+		t.Error("expected conflict on username to be detected from the message")
+	}
+	if !IsConflictOnField(wrap(http.StatusConflict, `{"field": "email", "errorMessage": "emailExistsMessage"}`), "email") {
+		t.Error("expected conflict on email to be detected from the field")
+	}
+	if IsConflictOnField(wrap(http.StatusConflict, `{"errorMessage": "User exists with same username"}`), "email") {
+		t.Error("expected conflict on username not to match email")
+	}
+	if IsConflictOnField(wrap(http.StatusBadRequest, `{"field": "email", "errorMessage": "invalidEmailMessage"}`), "email") {
+		t.Error("expected only conflicts to be matched")
+	}
+}
+
+func TestSendRequestReturnsApiError(t *testing.T) {
+	keycloakClient := newRetryTestClient(t, RetryPolicy{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"errorMessage": "Conflict detected. See logs for details"}`))
+	})
+
+	err := keycloakClient.put(testCtx, "/realms/test", &Realm{Realm: "test"})
+
+	apiError, ok := asApiError(err)
+	if !ok {
+		t.Fatalf("expected an ApiError, got %v", err)
+	}
+	if apiError.Code != http.StatusConflict || apiError.Method != http.MethodPut || apiError.Path != "/auth/admin/realms/test" {
+		t.Errorf("unexpected api error %#v", apiError)
+	}
+	if apiError.ErrorMessage != "Conflict detected. See logs for details" {
+		t.Errorf("expected error message to be parsed, got %s", apiError.ErrorMessage)
+	}
+}
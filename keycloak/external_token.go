@@ -0,0 +1,124 @@
+package keycloak
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ExternalToken lets the provider use access tokens that were issued elsewhere (for example by a token broker in CI)
+// instead of logging in with credentials of its own.
+type ExternalToken struct {
+	// An access token used as is until Keycloak rejects it
+	AccessToken string
+	// A shell command that prints an access token to stdout, either as is or as a JSON token response. It is executed
+	// whenever a new token is needed: on the first request if AccessToken is empty, and after Keycloak rejected a token.
+	TokenCommand string
+}
+
+func (externalToken ExternalToken) isConfigured() bool {
+	return externalToken.AccessToken != "" || externalToken.TokenCommand != ""
+}
+
+// Returns the token to use for the first request
+func (externalToken ExternalToken) initialToken(ctx context.Context) (ClientCredentials, error) {
+	if externalToken.AccessToken != "" {
+		return ClientCredentials{
+			AccessToken: externalToken.AccessToken,
+			TokenType:   "Bearer",
+		}, nil
+	}
+
+	return externalToken.fetchToken(ctx)
+}
+
+// Runs the token command to get a new access token
+func (externalToken ExternalToken) fetchToken(ctx context.Context) (ClientCredentials, error) {
+	if externalToken.TokenCommand == "" {
+		return ClientCredentials{}, fmt.Errorf("the access token was rejected by Keycloak, and no token command is configured to obtain a new one")
+	}
+
+	log.Printf("[DEBUG] Running token command to obtain a new access token")
+
+	var command *exec.Cmd
+	if runtime.GOOS == "windows" {
+		command = exec.CommandContext(ctx, "cmd", "/C", externalToken.TokenCommand)
+	} else {
+		command = exec.CommandContext(ctx, "sh", "-c", externalToken.TokenCommand)
+	}
+
+	var stdout, stderr bytes.Buffer
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+
+	err := command.Run()
+	if err != nil {
+		return ClientCredentials{}, fmt.Errorf("error running token command: %s: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return parseTokenCommandOutput(stdout.String())
+}
+
+// The token command can either print the access token itself, or a JSON token response like the one returned by
+// Keycloak's token endpoint
+func parseTokenCommandOutput(output string) (ClientCredentials, error) {
+	output = strings.TrimSpace(output)
+
+	var clientCredentials ClientCredentials
+
+	if strings.HasPrefix(output, "{") {
+		err := json.Unmarshal([]byte(output), &clientCredentials)
+		if err != nil {
+			return ClientCredentials{}, fmt.Errorf("error parsing token command output as JSON: %s", err)
+		}
+	} else {
+		clientCredentials.AccessToken = output
+	}
+
+	if clientCredentials.AccessToken == "" {
+		return ClientCredentials{}, fmt.Errorf("token command did not print an access token")
+	}
+
+	if clientCredentials.TokenType == "" {
+		clientCredentials.TokenType = "Bearer"
+	}
+
+	// without an explicit lifetime, the token is refreshed ahead of the expiry found in its claims
+	if clientCredentials.ExpiresIn == 0 {
+		if expiry, ok := getJwtExpiry(clientCredentials.AccessToken); ok {
+			clientCredentials.ExpiresIn = int(time.Until(expiry).Seconds())
+		}
+	}
+
+	return clientCredentials, nil
+}
+
+// Reads the "exp" claim of a JWT without verifying it, since that's up to Keycloak
+func getJwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Exp, 0), true
+}
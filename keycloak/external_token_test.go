@@ -0,0 +1,162 @@
+package keycloak
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type externalTokenTestServer struct {
+	*httptest.Server
+
+	validToken atomic.Value
+	tokenCalls int32
+}
+
+// A stand-in for Keycloak which only accepts a single access token, and fails the test if the provider tries to log in
+func newExternalTokenTestServer(t *testing.T, validToken string) *externalTokenTestServer {
+	server := &externalTokenTestServer{}
+	server.validToken.Store(validToken)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/realms/master/protocol/openid-connect/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&server.tokenCalls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	mux.HandleFunc("/auth/admin/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+server.validToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/auth/admin/serverinfo" {
+			w.Write([]byte(`{"systemInfo": {"version": "12.0.4"}}`))
+			return
+		}
+
+		w.Write([]byte(`{"id": "test", "realm": "test"}`))
+	})
+
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newExternalTokenTestClient(t *testing.T, server *externalTokenTestServer, externalToken ExternalToken) (*KeycloakClient, error) {
+	return NewKeycloakClient(testCtx, server.URL, "/auth", "", "", "master", "", "", true, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, externalToken)
+}
+
+func TestExternalAccessToken(t *testing.T) {
+	server := newExternalTokenTestServer(t, "pre-issued")
+
+	keycloakClient, err := newExternalTokenTestClient(t, server, ExternalToken{AccessToken: "pre-issued"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := keycloakClient.getRaw(testCtx, "/realms/test", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// once the token is rejected, there is no way to get a new one
+	server.validToken.Store("rotated")
+
+	if _, err := keycloakClient.getRaw(testCtx, "/realms/test", nil); err == nil {
+		t.Fatal("expected an error after the access token was rejected")
+	}
+
+	if atomic.LoadInt32(&server.tokenCalls) != 0 {
+		t.Fatal("expected the provider to not log in when an access token is specified")
+	}
+}
+
+func TestExternalTokenCommand(t *testing.T) {
+	server := newExternalTokenTestServer(t, "from-command")
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, tokenFile, "from-command")
+
+	keycloakClient, err := newExternalTokenTestClient(t, server, ExternalToken{TokenCommand: fmt.Sprintf("cat %s", tokenFile)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := keycloakClient.getRaw(testCtx, "/realms/test", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// the command is run again to fetch a fresh token after Keycloak rejected the current one
+	server.validToken.Store("rotated")
+	writeTokenFile(t, tokenFile, `{"access_token": "rotated", "token_type": "Bearer", "expires_in": 300}`)
+
+	if _, err := keycloakClient.getRaw(testCtx, "/realms/test", nil); err != nil {
+		t.Fatalf("expected request to succeed with a fresh token from the token command, got %s", err)
+	}
+
+	if atomic.LoadInt32(&server.tokenCalls) != 0 {
+		t.Fatal("expected the provider to not log in when a token command is specified")
+	}
+}
+
+func TestExternalAccessTokenWithTokenCommand(t *testing.T) {
+	server := newExternalTokenTestServer(t, "pre-issued")
+
+	keycloakClient, err := newExternalTokenTestClient(t, server, ExternalToken{AccessToken: "pre-issued", TokenCommand: "echo from-command"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if token := keycloakClient.currentToken(); token.value != "pre-issued" {
+		t.Fatalf("expected the access token to be used before running the token command, got %s", token.value)
+	}
+
+	server.validToken.Store("from-command")
+
+	if _, err := keycloakClient.getRaw(testCtx, "/realms/test", nil); err != nil {
+		t.Fatalf("expected request to succeed with a token from the token command, got %s", err)
+	}
+}
+
+func TestExternalTokenCommandFailure(t *testing.T) {
+	server := newExternalTokenTestServer(t, "from-command")
+
+	_, err := newExternalTokenTestClient(t, server, ExternalToken{TokenCommand: "echo broker unavailable >&2; exit 1"})
+	if err == nil {
+		t.Fatal("expected an error when the token command fails")
+	}
+
+	_, err = newExternalTokenTestClient(t, server, ExternalToken{TokenCommand: "true"})
+	if err == nil {
+		t.Fatal("expected an error when the token command does not print a token")
+	}
+}
+
+func TestParseTokenCommandOutputReadsJwtExpiry(t *testing.T) {
+	claims := fmt.Sprintf(`{"exp": %d}`, time.Now().Add(time.Hour).Unix())
+	jwt := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+
+	clientCredentials, err := parseTokenCommandOutput(jwt + "\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if clientCredentials.AccessToken != jwt || clientCredentials.TokenType != "Bearer" {
+		t.Fatalf("unexpected token %+v", clientCredentials)
+	}
+	if clientCredentials.ExpiresIn < 3590 || clientCredentials.ExpiresIn > 3600 {
+		t.Fatalf("expected token to expire in an hour, got %d seconds", clientCredentials.ExpiresIn)
+	}
+}
+
+func writeTokenFile(t *testing.T, path, content string) {
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
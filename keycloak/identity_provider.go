@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -123,9 +124,9 @@ func (f *IdentityProviderConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
-func (keycloakClient *KeycloakClient) NewIdentityProvider(identityProvider *IdentityProvider) error {
+func (keycloakClient *KeycloakClient) NewIdentityProvider(ctx context.Context, identityProvider *IdentityProvider) error {
 	log.Printf("[WARN] Realm: %s", identityProvider.Realm)
-	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/identity-provider/instances", identityProvider.Realm), identityProvider)
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances", identityProvider.Realm), identityProvider)
 	if err != nil {
 		return err
 	}
@@ -133,11 +134,11 @@ func (keycloakClient *KeycloakClient) NewIdentityProvider(identityProvider *Iden
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetIdentityProvider(realm, alias string) (*IdentityProvider, error) {
+func (keycloakClient *KeycloakClient) GetIdentityProvider(ctx context.Context, realm, alias string) (*IdentityProvider, error) {
 	var identityProvider IdentityProvider
 	identityProvider.Realm = realm
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/identity-provider/instances/%s", realm, alias), &identityProvider, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", realm, alias), &identityProvider, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -145,10 +146,29 @@ func (keycloakClient *KeycloakClient) GetIdentityProvider(realm, alias string) (
 	return &identityProvider, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateIdentityProvider(identityProvider *IdentityProvider) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/identity-provider/instances/%s", identityProvider.Realm, identityProvider.Alias), identityProvider)
+func (keycloakClient *KeycloakClient) UpdateIdentityProvider(ctx context.Context, identityProvider *IdentityProvider) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", identityProvider.Realm, identityProvider.Alias), identityProvider)
 }
 
-func (keycloakClient *KeycloakClient) DeleteIdentityProvider(realm, alias string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/identity-provider/instances/%s", realm, alias), nil)
+func (keycloakClient *KeycloakClient) DeleteIdentityProvider(ctx context.Context, realm, alias string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", realm, alias), nil)
+}
+
+// ImportIdentityProviderConfig has Keycloak fetch and parse the metadata an identity provider publishes, such as an
+// OpenID Connect discovery document, into identity provider config
+func (keycloakClient *KeycloakClient) ImportIdentityProviderConfig(ctx context.Context, realm, providerId, fromUrl string) (map[string]string, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/identity-provider/import-config", realm), map[string]string{
+		"providerId": providerId,
+		"fromUrl":    fromUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]string{}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
 }
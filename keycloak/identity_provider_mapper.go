@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 	"log"
 )
@@ -15,9 +16,9 @@ type IdentityProviderMapper struct {
 	Config                 map[string]interface{} `json:"config,omitempty"`
 }
 
-func (keycloakClient *KeycloakClient) NewIdentityProviderMapper(identityProviderMapper *IdentityProviderMapper) error {
+func (keycloakClient *KeycloakClient) NewIdentityProviderMapper(ctx context.Context, identityProviderMapper *IdentityProviderMapper) error {
 	log.Printf("[WARN] Realm: %s", identityProviderMapper.Realm)
-	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers", identityProviderMapper.Realm, identityProviderMapper.IdentityProviderAlias), identityProviderMapper)
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers", identityProviderMapper.Realm, identityProviderMapper.IdentityProviderAlias), identityProviderMapper)
 	if err != nil {
 		return err
 	}
@@ -27,12 +28,12 @@ func (keycloakClient *KeycloakClient) NewIdentityProviderMapper(identityProvider
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetIdentityProviderMapper(realm, idpAlias, id string) (*IdentityProviderMapper, error) {
+func (keycloakClient *KeycloakClient) GetIdentityProviderMapper(ctx context.Context, realm, idpAlias, id string) (*IdentityProviderMapper, error) {
 	var identityProviderMapper IdentityProviderMapper
 	identityProviderMapper.Realm = realm
 	identityProviderMapper.IdentityProviderAlias = idpAlias
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers/%s", realm, idpAlias, id), &identityProviderMapper, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers/%s", realm, idpAlias, id), &identityProviderMapper, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,10 +41,10 @@ func (keycloakClient *KeycloakClient) GetIdentityProviderMapper(realm, idpAlias,
 	return &identityProviderMapper, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateIdentityProviderMapper(identityProviderMapper *IdentityProviderMapper) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers/%s", identityProviderMapper.Realm, identityProviderMapper.IdentityProviderAlias, identityProviderMapper.Id), identityProviderMapper)
+func (keycloakClient *KeycloakClient) UpdateIdentityProviderMapper(ctx context.Context, identityProviderMapper *IdentityProviderMapper) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers/%s", identityProviderMapper.Realm, identityProviderMapper.IdentityProviderAlias, identityProviderMapper.Id), identityProviderMapper)
 }
 
-func (keycloakClient *KeycloakClient) DeleteIdentityProviderMapper(realm, idpAlias, id string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers/%s", realm, idpAlias, id), nil)
+func (keycloakClient *KeycloakClient) DeleteIdentityProviderMapper(ctx context.Context, realm, idpAlias, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers/%s", realm, idpAlias, id), nil)
 }
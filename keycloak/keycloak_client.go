@@ -1,17 +1,17 @@
 package keycloak
 
 import (
-	"bytes"
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-version"
@@ -20,7 +20,9 @@ import (
 )
 
 type KeycloakClient struct {
+	url               string
 	baseUrl           string
+	detectBasePath    bool
 	realm             string
 	clientCredentials *ClientCredentials
 	httpClient        *http.Client
@@ -28,6 +30,19 @@ type KeycloakClient struct {
 	userAgent         string
 	version           *version.Version
 	additionalHeaders map[string]string
+	retryPolicy       RetryPolicy
+	assertionSigner   *clientAssertionSigner
+	externalToken     ExternalToken
+
+	// tokenMutex guards the tokens in clientCredentials as well as everything else that changes when logging in.
+	// loginMutex ensures that only one goroutine at a time logs in or refreshes the tokens.
+	tokenMutex      sync.RWMutex
+	loginMutex      sync.Mutex
+	tokenGeneration uint64
+	tokenRefreshAt  time.Time
+
+	// clientPoliciesMutex serializes changes to client policies and profiles, which Keycloak only updates as a whole
+	clientPoliciesMutex sync.Mutex
 }
 
 type ClientCredentials struct {
@@ -39,14 +54,21 @@ type ClientCredentials struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
 }
 
 const (
 	apiUrl   = "/admin"
 	tokenUrl = "%s/realms/%s/protocol/openid-connect/token"
+
+	wellKnownUrl = "%s/realms/%s/.well-known/openid-configuration"
 )
 
-func NewKeycloakClient(url, basePath, clientId, clientSecret, realm, username, password string, initialLogin bool, clientTimeout int, caCert string, tlsInsecureSkipVerify bool, userAgent string, additionalHeaders map[string]string) (*KeycloakClient, error) {
+// When no base path is configured, these are tried in order. Keycloak.X (Quarkus) serves everything from the root,
+// while older versions, and Keycloak.X started with --http-relative-path=/auth, serve it from /auth.
+var basePathCandidates = []string{"/auth", ""}
+
+func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecret, realm, username, password string, initialLogin bool, clientTimeout int, transportOptions TransportOptions, userAgent string, additionalHeaders map[string]string, retryPolicy RetryPolicy, rateLimit RateLimit, clientAssertion ClientAssertion, externalToken ExternalToken) (*KeycloakClient, error) {
 	cookieJar, err := cookiejar.New(&cookiejar.Options{
 		PublicSuffixList: publicsuffix.List,
 	})
@@ -54,52 +76,65 @@ func NewKeycloakClient(url, basePath, clientId, clientSecret, realm, username, p
 	if err != nil {
 		return nil, err
 	}
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: tlsInsecureSkipVerify},
-		Proxy:           http.ProxyFromEnvironment,
+
+	transport, err := newHttpTransport(transportOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	recordingTransport, err := newRecordingTransport(transport, transportOptions.Recording)
+	if err != nil {
+		return nil, err
 	}
 
 	httpClient := &http.Client{
 		Timeout:   time.Second * time.Duration(clientTimeout),
-		Transport: transport,
+		Transport: newRateLimitedTransport(recordingTransport, rateLimit),
 		Jar:       cookieJar,
 	}
 
-	if caCert != "" {
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM([]byte(caCert))
-		httpClient.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs: caCertPool,
-			},
-		}
+	assertionSigner, err := newClientAssertionSigner(clientAssertion)
+	if err != nil {
+		return nil, err
 	}
+
 	clientCredentials := &ClientCredentials{
 		ClientId:     clientId,
 		ClientSecret: clientSecret,
 	}
-	if password != "" && username != "" {
+	if clientId == "" && !externalToken.isConfigured() {
+		return nil, fmt.Errorf("must specify client id, unless an access token or token command is used")
+	} else if password != "" && username != "" {
 		clientCredentials.Username = username
 		clientCredentials.Password = password
 		clientCredentials.GrantType = "password"
-	} else if clientSecret != "" {
+	} else if clientSecret != "" || assertionSigner != nil {
 		clientCredentials.GrantType = "client_credentials"
-	} else {
-		return nil, fmt.Errorf("must specify client id, username and password for password grant, or client id and secret for client credentials grant")
+	} else if !externalToken.isConfigured() {
+		return nil, fmt.Errorf("must specify client id, username and password for password grant, client id and secret or client assertion private key for client credentials grant, or an access token or token command")
 	}
 
+	// an empty base path is detected when logging in, while "/" explicitly selects the root
+	detectBasePath := basePath == ""
+	basePath = strings.TrimSuffix(basePath, "/")
+
 	keycloakClient := KeycloakClient{
+		url:               url,
 		baseUrl:           url + basePath,
+		detectBasePath:    detectBasePath,
 		clientCredentials: clientCredentials,
 		httpClient:        httpClient,
 		initialLogin:      initialLogin,
 		realm:             realm,
 		userAgent:         userAgent,
 		additionalHeaders: additionalHeaders,
+		retryPolicy:       retryPolicy,
+		assertionSigner:   assertionSigner,
+		externalToken:     externalToken,
 	}
 
 	if keycloakClient.initialLogin {
-		err := keycloakClient.login()
+		err := keycloakClient.login(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -108,13 +143,34 @@ func NewKeycloakClient(url, basePath, clientId, clientSecret, realm, username, p
 	return &keycloakClient, nil
 }
 
-func (keycloakClient *KeycloakClient) login() error {
+func (keycloakClient *KeycloakClient) login(ctx context.Context) error {
+	if keycloakClient.detectBasePath {
+		err := keycloakClient.discoverBasePath(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if keycloakClient.externalToken.isConfigured() {
+		clientCredentials, err := keycloakClient.externalToken.initialToken(ctx)
+		if err != nil {
+			return err
+		}
+
+		keycloakClient.setToken(clientCredentials)
+
+		return keycloakClient.fetchServerVersion(ctx)
+	}
+
 	accessTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.realm)
-	accessTokenData := keycloakClient.getAuthenticationFormData()
+	accessTokenData, err := keycloakClient.getAuthenticationFormData(accessTokenUrl)
+	if err != nil {
+		return err
+	}
 
-	log.Printf("[DEBUG] Login request: %s", accessTokenData.Encode())
+	log.Printf("[DEBUG] Login request: %s", redactFormData(accessTokenData))
 
-	accessTokenRequest, err := http.NewRequest(http.MethodPost, accessTokenUrl, strings.NewReader(accessTokenData.Encode()))
+	accessTokenRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, accessTokenUrl, strings.NewReader(accessTokenData.Encode()))
 	if err != nil {
 		return err
 	}
@@ -133,15 +189,16 @@ func (keycloakClient *KeycloakClient) login() error {
 	if err != nil {
 		return err
 	}
-	if accessTokenResponse.StatusCode != http.StatusOK {
-		return fmt.Errorf("error sending POST request to %s: %s", accessTokenUrl, accessTokenResponse.Status)
-	}
-
-	defer accessTokenResponse.Body.Close()
 
+	// the response is closed before fetching the server info, so it doesn't hold on to a concurrent request slot
 	body, _ := ioutil.ReadAll(accessTokenResponse.Body)
+	accessTokenResponse.Body.Close()
 
-	log.Printf("[DEBUG] Login response: %s", body)
+	if accessTokenResponse.StatusCode != http.StatusOK {
+		return newApiError(http.MethodPost, accessTokenUrl, accessTokenResponse.StatusCode, accessTokenResponse.Status, body)
+	}
+
+	log.Printf("[DEBUG] Login response: %s", redactJson(body))
 
 	var clientCredentials ClientCredentials
 	err = json.Unmarshal(body, &clientCredentials)
@@ -149,37 +206,125 @@ func (keycloakClient *KeycloakClient) login() error {
 		return err
 	}
 
-	keycloakClient.clientCredentials.AccessToken = clientCredentials.AccessToken
-	keycloakClient.clientCredentials.RefreshToken = clientCredentials.RefreshToken
-	keycloakClient.clientCredentials.TokenType = clientCredentials.TokenType
+	keycloakClient.setToken(clientCredentials)
+
+	return keycloakClient.fetchServerVersion(ctx)
+}
+
+// Finds the base path by probing the OpenID configuration of the realm used for logging in, which can be fetched
+// without being authenticated
+func (keycloakClient *KeycloakClient) discoverBasePath(ctx context.Context) error {
+	for _, basePath := range basePathCandidates {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(wellKnownUrl, keycloakClient.url+basePath, keycloakClient.realm), nil)
+		if err != nil {
+			return err
+		}
+
+		for header, value := range keycloakClient.additionalHeaders {
+			request.Header.Set(header, value)
+		}
+
+		if keycloakClient.userAgent != "" {
+			request.Header.Set("User-Agent", keycloakClient.userAgent)
+		}
+
+		response, err := keycloakClient.httpClient.Do(request)
+		if err != nil {
+			return fmt.Errorf("error detecting base path: %w", err)
+		}
+
+		io.Copy(ioutil.Discard, response.Body)
+		response.Body.Close()
+
+		log.Printf("[DEBUG] Probing base path \"%s\": %s", basePath, response.Status)
+
+		if response.StatusCode == http.StatusOK {
+			keycloakClient.tokenMutex.Lock()
+			keycloakClient.baseUrl = keycloakClient.url + basePath
+			keycloakClient.detectBasePath = false
+			keycloakClient.tokenMutex.Unlock()
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unable to detect the base path of Keycloak at %s: realm %s could not be found with any of the base paths %q, please set base_path", keycloakClient.url, keycloakClient.realm, basePathCandidates)
+}
+
+func (keycloakClient *KeycloakClient) getBaseUrl() string {
+	keycloakClient.tokenMutex.RLock()
+	defer keycloakClient.tokenMutex.RUnlock()
+
+	return keycloakClient.baseUrl
+}
+
+// Returns the URL of the given Admin API path. As the base path may only be known after logging in, the client
+// logs in first if necessary.
+func (keycloakClient *KeycloakClient) resourceUrl(ctx context.Context, path string) (string, error) {
+	if _, err := keycloakClient.ensureAuthenticated(ctx); err != nil {
+		return "", &LoginError{Message: "error logging in", Err: err}
+	}
+
+	return keycloakClient.getBaseUrl() + apiUrl + path, nil
+}
 
-	info, err := keycloakClient.GetServerInfo()
+// The server info is fetched while the login lock is held, so it can't go through sendRequest, which would wait for that
+// same lock if the request needed to be retried with a refreshed token.
+func (keycloakClient *KeycloakClient) fetchServerVersion(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, keycloakClient.baseUrl+apiUrl+"/serverinfo", nil)
 	if err != nil {
 		return err
 	}
 
-	server_version := info.SystemInfo.ServerVersion
-	if strings.Contains(server_version, ".GA") {
-		server_version = strings.ReplaceAll(info.SystemInfo.ServerVersion, ".GA", "")
+	response, err := keycloakClient.doWithRetry(ctx, request, nil, keycloakClient.currentToken())
+	if err != nil {
+		return fmt.Errorf("error sending request: %v", err)
 	}
 
-	v, err := version.NewVersion(server_version)
+	body, _, err := keycloakClient.readResponse(request, response)
 	if err != nil {
 		return err
 	}
 
+	var info ServerInfo
+	err = json.Unmarshal(body, &info)
+	if err != nil {
+		return err
+	}
+
+	v, err := parseServerVersion(info.SystemInfo.ServerVersion)
+	if err != nil {
+		return err
+	}
+
+	keycloakClient.tokenMutex.Lock()
 	keycloakClient.version = v
+	keycloakClient.tokenMutex.Unlock()
 
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) refresh() error {
+func (keycloakClient *KeycloakClient) refresh(ctx context.Context) error {
+	if keycloakClient.externalToken.isConfigured() {
+		clientCredentials, err := keycloakClient.externalToken.fetchToken(ctx)
+		if err != nil {
+			return err
+		}
+
+		keycloakClient.setToken(clientCredentials)
+
+		return nil
+	}
+
 	refreshTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.realm)
-	refreshTokenData := keycloakClient.getAuthenticationFormData()
+	refreshTokenData, err := keycloakClient.getAuthenticationFormData(refreshTokenUrl)
+	if err != nil {
+		return err
+	}
 
-	log.Printf("[DEBUG] Refresh request: %s", refreshTokenData.Encode())
+	log.Printf("[DEBUG] Refresh request: %s", redactFormData(refreshTokenData))
 
-	refreshTokenRequest, err := http.NewRequest(http.MethodPost, refreshTokenUrl, strings.NewReader(refreshTokenData.Encode()))
+	refreshTokenRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, refreshTokenUrl, strings.NewReader(refreshTokenData.Encode()))
 	if err != nil {
 		return err
 	}
@@ -199,17 +344,17 @@ func (keycloakClient *KeycloakClient) refresh() error {
 		return err
 	}
 
-	defer refreshTokenResponse.Body.Close()
-
+	// the body is closed before logging in again, as that releases the slot the request holds when requests are limited
 	body, _ := ioutil.ReadAll(refreshTokenResponse.Body)
+	refreshTokenResponse.Body.Close()
 
-	log.Printf("[DEBUG] Refresh response: %s", body)
+	log.Printf("[DEBUG] Refresh response: %s", redactJson(body))
 
 	// Handle 401 "User or client no longer has role permissions for client key" until I better understand why that happens in the first place
 	if refreshTokenResponse.StatusCode == http.StatusBadRequest {
 		log.Printf("[DEBUG] Unexpected 400, attemting to log in again")
 
-		return keycloakClient.login()
+		return keycloakClient.login(ctx)
 	}
 
 	var clientCredentials ClientCredentials
@@ -218,14 +363,12 @@ func (keycloakClient *KeycloakClient) refresh() error {
 		return err
 	}
 
-	keycloakClient.clientCredentials.AccessToken = clientCredentials.AccessToken
-	keycloakClient.clientCredentials.RefreshToken = clientCredentials.RefreshToken
-	keycloakClient.clientCredentials.TokenType = clientCredentials.TokenType
+	keycloakClient.setToken(clientCredentials)
 
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) getAuthenticationFormData() url.Values {
+func (keycloakClient *KeycloakClient) getAuthenticationFormData(tokenEndpointUrl string) (url.Values, error) {
 	authenticationFormData := url.Values{}
 	authenticationFormData.Set("client_id", keycloakClient.clientCredentials.ClientId)
 	authenticationFormData.Set("grant_type", keycloakClient.clientCredentials.GrantType)
@@ -233,33 +376,41 @@ func (keycloakClient *KeycloakClient) getAuthenticationFormData() url.Values {
 	if keycloakClient.clientCredentials.GrantType == "password" {
 		authenticationFormData.Set("username", keycloakClient.clientCredentials.Username)
 		authenticationFormData.Set("password", keycloakClient.clientCredentials.Password)
+	}
 
-		if keycloakClient.clientCredentials.ClientSecret != "" {
-			authenticationFormData.Set("client_secret", keycloakClient.clientCredentials.ClientSecret)
+	// the client authenticates itself with a freshly signed assertion if a private key is configured, or its secret otherwise
+	if keycloakClient.assertionSigner != nil {
+		clientAssertion, err := keycloakClient.assertionSigner.sign(keycloakClient.clientCredentials.ClientId, tokenEndpointUrl)
+		if err != nil {
+			return nil, err
 		}
 
-	} else if keycloakClient.clientCredentials.GrantType == "client_credentials" {
+		authenticationFormData.Set("client_assertion_type", clientAssertionType)
+		authenticationFormData.Set("client_assertion", clientAssertion)
+	} else if keycloakClient.clientCredentials.ClientSecret != "" || keycloakClient.clientCredentials.GrantType == "client_credentials" {
 		authenticationFormData.Set("client_secret", keycloakClient.clientCredentials.ClientSecret)
 	}
 
-	return authenticationFormData
+	return authenticationFormData, nil
 }
 
-func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
-	tokenType := keycloakClient.clientCredentials.TokenType
-	accessToken := keycloakClient.clientCredentials.AccessToken
-
+func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request, token accessToken) {
 	for header, value := range keycloakClient.additionalHeaders {
 		request.Header.Set(header, value)
 	}
 
-	request.Header.Set("Authorization", fmt.Sprintf("%s %s", tokenType, accessToken))
+	request.Header.Set("Authorization", fmt.Sprintf("%s %s", token.tokenType, token.value))
 	request.Header.Set("Accept", "application/json")
 
 	if keycloakClient.userAgent != "" {
 		request.Header.Set("User-Agent", keycloakClient.userAgent)
 	}
 
+	// requests sending something other than json set their own content type
+	if request.Header.Get("Content-Type") != "" {
+		return
+	}
+
 	if request.Method == http.MethodPost || request.Method == http.MethodPut || request.Method == http.MethodDelete {
 		request.Header.Set("Content-type", "application/json")
 	}
@@ -268,13 +419,10 @@ func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
 /**
 Sends an HTTP request and refreshes credentials on 403 or 401 errors
 */
-func (keycloakClient *KeycloakClient) sendRequest(request *http.Request, body []byte) ([]byte, string, error) {
-	if !keycloakClient.initialLogin {
-		keycloakClient.initialLogin = true
-		err := keycloakClient.login()
-		if err != nil {
-			return nil, "", fmt.Errorf("error logging in: %s", err)
-		}
+func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *http.Request, body []byte) ([]byte, string, error) {
+	token, err := keycloakClient.ensureAuthenticated(ctx)
+	if err != nil {
+		return nil, "", &LoginError{Message: "error logging in", Err: err}
 	}
 
 	requestMethod := request.Method
@@ -282,15 +430,12 @@ func (keycloakClient *KeycloakClient) sendRequest(request *http.Request, body []
 
 	log.Printf("[DEBUG] Sending %s to %s", requestMethod, requestPath)
 	if body != nil {
-		request.Body = ioutil.NopCloser(bytes.NewReader(body))
-		log.Printf("[DEBUG] Request body: %s", string(body))
+		log.Printf("[DEBUG] Request body: %s", redactJson(body))
 	}
 
-	keycloakClient.addRequestHeaders(request)
-
-	response, err := keycloakClient.httpClient.Do(request)
+	response, err := keycloakClient.doWithRetry(ctx, request, body, token)
 	if err != nil {
-		return nil, "", fmt.Errorf("error sending request: %v", err)
+		return nil, "", fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Unauthorized: Token could have expired
@@ -298,22 +443,23 @@ func (keycloakClient *KeycloakClient) sendRequest(request *http.Request, body []
 	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
 		log.Printf("[DEBUG] Response: %s.  Attempting refresh", response.Status)
 
-		err := keycloakClient.refresh()
+		response.Body.Close()
+
+		err := keycloakClient.refreshToken(ctx, token)
 		if err != nil {
-			return nil, "", fmt.Errorf("error refreshing credentials: %s", err)
+			return nil, "", &LoginError{Message: "error refreshing credentials", Err: err}
 		}
 
-		keycloakClient.addRequestHeaders(request)
-
-		if body != nil {
-			request.Body = ioutil.NopCloser(bytes.NewReader(body))
-		}
-		response, err = keycloakClient.httpClient.Do(request)
+		response, err = keycloakClient.doWithRetry(ctx, request, body, keycloakClient.currentToken())
 		if err != nil {
-			return nil, "", fmt.Errorf("error sending request after refresh: %v", err)
+			return nil, "", fmt.Errorf("error sending request after refresh: %w", err)
 		}
 	}
 
+	return keycloakClient.readResponse(request, response)
+}
+
+func (keycloakClient *KeycloakClient) readResponse(request *http.Request, response *http.Response) ([]byte, string, error) {
 	log.Printf("[DEBUG] Response: %s", response.Status)
 
 	defer response.Body.Close()
@@ -323,38 +469,32 @@ func (keycloakClient *KeycloakClient) sendRequest(request *http.Request, body []
 		return nil, "", err
 	}
 
-	if len(responseBody) != 0 && request.URL.Path != "/auth/admin/serverinfo" {
-		log.Printf("[DEBUG] Response body: %s", responseBody)
+	if len(responseBody) != 0 && !strings.HasSuffix(request.URL.Path, apiUrl+"/serverinfo") {
+		log.Printf("[DEBUG] Response body: %s", redactJson(responseBody))
 	}
 
 	if response.StatusCode >= 400 {
-		errorMessage := fmt.Sprintf("error sending %s request to %s: %s.", request.Method, request.URL.Path, response.Status)
-
-		if len(responseBody) != 0 {
-			errorMessage = fmt.Sprintf("%s Response body: %s", errorMessage, responseBody)
-		}
-
-		return nil, "", &ApiError{
-			Code:    response.StatusCode,
-			Message: errorMessage,
-		}
+		return nil, "", newApiError(request.Method, request.URL.Path, response.StatusCode, response.Status, responseBody)
 	}
 
 	return responseBody, response.Header.Get("Location"), nil
 }
 
-func (keycloakClient *KeycloakClient) get(path string, resource interface{}, params map[string]string) error {
-	body, err := keycloakClient.getRaw(path, params)
+func (keycloakClient *KeycloakClient) get(ctx context.Context, path string, resource interface{}, params map[string]string) error {
+	body, err := keycloakClient.getRaw(ctx, path, params)
 	if err != nil {
 		return err
 	}
 	return json.Unmarshal(body, resource)
 }
 
-func (keycloakClient *KeycloakClient) getRaw(path string, params map[string]string) ([]byte, error) {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+func (keycloakClient *KeycloakClient) getRaw(ctx context.Context, path string, params map[string]string) ([]byte, error) {
+	resourceUrl, err := keycloakClient.resourceUrl(ctx, path)
+	if err != nil {
+		return nil, err
+	}
 
-	request, err := http.NewRequest(http.MethodGet, resourceUrl, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceUrl, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -367,67 +507,94 @@ func (keycloakClient *KeycloakClient) getRaw(path string, params map[string]stri
 		request.URL.RawQuery = query.Encode()
 	}
 
-	body, _, err := keycloakClient.sendRequest(request, nil)
+	body, _, err := keycloakClient.sendRequest(ctx, request, nil)
 	return body, err
 }
 
-func (keycloakClient *KeycloakClient) sendRaw(path string, requestBody []byte) ([]byte, error) {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+func (keycloakClient *KeycloakClient) sendRaw(ctx context.Context, path string, requestBody []byte) ([]byte, error) {
+	resourceUrl, err := keycloakClient.resourceUrl(ctx, path)
+	if err != nil {
+		return nil, err
+	}
 
-	request, err := http.NewRequest(http.MethodPost, resourceUrl, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, resourceUrl, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, _, err := keycloakClient.sendRequest(request, requestBody)
+	body, _, err := keycloakClient.sendRequest(ctx, request, requestBody)
 
 	return body, err
 }
 
-func (keycloakClient *KeycloakClient) post(path string, requestBody interface{}) ([]byte, string, error) {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+func (keycloakClient *KeycloakClient) post(ctx context.Context, path string, requestBody interface{}) ([]byte, string, error) {
+	resourceUrl, err := keycloakClient.resourceUrl(ctx, path)
+	if err != nil {
+		return nil, "", err
+	}
 
 	payload, err := json.Marshal(requestBody)
 	if err != nil {
 		return nil, "", err
 	}
 
-	request, err := http.NewRequest(http.MethodPost, resourceUrl, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, resourceUrl, nil)
 	if err != nil {
 		return nil, "", err
 	}
 
-	body, location, err := keycloakClient.sendRequest(request, payload)
+	body, location, err := keycloakClient.sendRequest(ctx, request, payload)
 
 	return body, location, err
 }
 
-func (keycloakClient *KeycloakClient) put(path string, requestBody interface{}) error {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+func (keycloakClient *KeycloakClient) put(ctx context.Context, path string, requestBody interface{}) error {
+	resourceUrl, err := keycloakClient.resourceUrl(ctx, path)
+	if err != nil {
+		return err
+	}
 
 	payload, err := json.Marshal(requestBody)
 	if err != nil {
 		return err
 	}
 
-	request, err := http.NewRequest(http.MethodPut, resourceUrl, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, resourceUrl, nil)
 	if err != nil {
 		return err
 	}
 
-	_, _, err = keycloakClient.sendRequest(request, payload)
+	_, _, err = keycloakClient.sendRequest(ctx, request, payload)
 
 	return err
 }
 
-func (keycloakClient *KeycloakClient) delete(path string, requestBody interface{}) error {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+// Sends a PUT request with a plain text body, which some endpoints such as the realm localization texts expect
+func (keycloakClient *KeycloakClient) putText(ctx context.Context, path, text string) error {
+	resourceUrl, err := keycloakClient.resourceUrl(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, resourceUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "text/plain")
+
+	_, _, err = keycloakClient.sendRequest(ctx, request, []byte(text))
+
+	return err
+}
 
-	var (
-		payload []byte
-		err     error
-	)
+func (keycloakClient *KeycloakClient) delete(ctx context.Context, path string, requestBody interface{}) error {
+	resourceUrl, err := keycloakClient.resourceUrl(ctx, path)
+	if err != nil {
+		return err
+	}
 
+	var payload []byte
 	if requestBody != nil {
 		payload, err = json.Marshal(requestBody)
 		if err != nil {
@@ -435,12 +602,12 @@ func (keycloakClient *KeycloakClient) delete(path string, requestBody interface{
 		}
 	}
 
-	request, err := http.NewRequest(http.MethodDelete, resourceUrl, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, resourceUrl, nil)
 	if err != nil {
 		return err
 	}
 
-	_, _, err = keycloakClient.sendRequest(request, payload)
+	_, _, err = keycloakClient.sendRequest(ctx, request, payload)
 
 	return err
 }
@@ -0,0 +1,382 @@
+package keycloak
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/joed22636/terraform-provider-keycloak/keycloak/keycloaktest"
+)
+
+// These tests run against the in-memory server from the keycloaktest package, so they don't need a running Keycloak
+
+func newOfflineKeycloakClient(t *testing.T, options ...keycloaktest.Option) (*KeycloakClient, *keycloaktest.Server) {
+	t.Helper()
+
+	server := keycloaktest.NewServer(options...)
+	t.Cleanup(server.Close)
+
+	keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "", keycloaktest.ClientId, keycloaktest.ClientSecret, keycloaktest.Realm, "", "", true, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	return keycloakClient, server
+}
+
+func TestOfflineLogin(t *testing.T) {
+	for _, basePath := range []string{"/auth", ""} {
+		keycloakClient, _ := newOfflineKeycloakClient(t, keycloaktest.WithBasePath(basePath), keycloaktest.WithServerVersion("21.1.2"))
+
+		if !keycloakClient.VersionIsGreaterThanOrEqualTo(Version_21) || keycloakClient.VersionIsGreaterThanOrEqualTo(Version_22) {
+			t.Errorf("expected server version 21.1.2 with base path %q", basePath)
+		}
+
+		if _, err := keycloakClient.GetRealm(testCtx, keycloaktest.Realm); err != nil {
+			t.Errorf("error getting realm with base path %q: %s", basePath, err)
+		}
+	}
+}
+
+func TestOfflineTokenRefresh(t *testing.T) {
+	keycloakClient, server := newOfflineKeycloakClient(t)
+
+	tokenRequests := server.TokenRequests()
+	server.ExpireTokens()
+
+	if _, err := keycloakClient.GetRealm(testCtx, keycloaktest.Realm); err != nil {
+		t.Fatalf("expected the request to succeed after refreshing the token, got %s", err)
+	}
+
+	if server.TokenRequests() != tokenRequests+1 {
+		t.Errorf("expected exactly one refresh, got %d token requests", server.TokenRequests()-tokenRequests)
+	}
+}
+
+func TestOfflineErrorHandling(t *testing.T) {
+	keycloakClient, server := newOfflineKeycloakClient(t)
+
+	server.FailNext(http.MethodGet, "/admin/realms/master/groups/foo", http.StatusBadRequest, `{"errorMessage":"Invalid group id"}`)
+
+	_, err := keycloakClient.GetGroup(testCtx, keycloaktest.Realm, "foo")
+
+	var apiError *ApiError
+	if !errors.As(err, &apiError) {
+		t.Fatalf("expected an ApiError, got %v", err)
+	}
+	if !ErrorIs400(err) || apiError.ErrorMessage != "Invalid group id" {
+		t.Errorf("expected the error to be parsed, got %+v", apiError)
+	}
+
+	// the failure is only injected once
+	_, err = keycloakClient.GetGroup(testCtx, keycloaktest.Realm, "foo")
+	if !ErrorIs404(err) {
+		t.Errorf("expected a 404 for a group that doesn't exist, got %v", err)
+	}
+}
+
+func TestOfflineLoginErrorIsNotNotFound(t *testing.T) {
+	server := keycloaktest.NewServer()
+	t.Cleanup(server.Close)
+
+	// the token endpoint responds with 404 when the base path is wrong
+	keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "/wrong", keycloaktest.ClientId, keycloaktest.ClientSecret, keycloaktest.Realm, "", "", false, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	_, err = keycloakClient.GetGroup(testCtx, keycloaktest.Realm, "foo")
+
+	var loginError *LoginError
+	if !errors.As(err, &loginError) {
+		t.Fatalf("expected a LoginError, got %v", err)
+	}
+	if ErrorIs404(err) || ErrorIsForbidden(err) || ErrorIs400(err) {
+		t.Errorf("expected a failed login not to be reported as an error of the request, got %v", err)
+	}
+	if !ErrorIs404(loginError.Err) {
+		t.Errorf("expected the cause to be the 404 from the token endpoint, got %v", loginError.Err)
+	}
+}
+
+func TestOfflineConflict(t *testing.T) {
+	keycloakClient, _ := newOfflineKeycloakClient(t)
+
+	for i := 0; i < 2; i++ {
+		err := keycloakClient.NewUser(testCtx, &User{RealmId: keycloaktest.Realm, Username: "duplicate", Enabled: true})
+		if i == 0 && err != nil {
+			t.Fatalf("error creating user: %s", err)
+		}
+		if i == 1 && !IsConflictOnField(err, "username") {
+			t.Errorf("expected a conflict on the username, got %v", err)
+		}
+	}
+}
+
+func TestOfflineIdFromLocation(t *testing.T) {
+	keycloakClient, _ := newOfflineKeycloakClient(t)
+
+	parent := &Group{RealmId: keycloaktest.Realm, Name: "parent"}
+	if err := keycloakClient.NewGroup(testCtx, parent); err != nil {
+		t.Fatalf("error creating group: %s", err)
+	}
+
+	child := &Group{RealmId: keycloaktest.Realm, ParentId: parent.Id, Name: "child"}
+	if err := keycloakClient.NewGroup(testCtx, child); err != nil {
+		t.Fatalf("error creating group: %s", err)
+	}
+
+	group, err := keycloakClient.GetGroup(testCtx, keycloaktest.Realm, child.Id)
+	if err != nil {
+		t.Fatalf("error getting group %s: %s", child.Id, err)
+	}
+
+	if group.Name != "child" || group.Path != "/parent/child" {
+		t.Errorf("expected the group created as /parent/child, got %s", group.Path)
+	}
+}
+
+func TestOfflineGroupMembersPagination(t *testing.T) {
+	keycloakClient, server := newOfflineKeycloakClient(t)
+
+	group := &Group{RealmId: keycloaktest.Realm, Name: "members"}
+	if err := keycloakClient.NewGroup(testCtx, group); err != nil {
+		t.Fatalf("error creating group: %s", err)
+	}
+
+	var usernames []interface{}
+	for i := 0; i < 120; i++ {
+		user := &User{RealmId: keycloaktest.Realm, Username: fmt.Sprintf("user-%03d", i), Enabled: true}
+		if err := keycloakClient.NewUser(testCtx, user); err != nil {
+			t.Fatalf("error creating user: %s", err)
+		}
+
+		usernames = append(usernames, user.Username)
+	}
+
+	if err := keycloakClient.AddUsersToGroup(testCtx, keycloaktest.Realm, group.Id, usernames); err != nil {
+		t.Fatalf("error adding users to group: %s", err)
+	}
+
+	members, err := keycloakClient.GetGroupMembers(testCtx, keycloaktest.Realm, group.Id)
+	if err != nil {
+		t.Fatalf("error getting group members: %s", err)
+	}
+
+	if len(members) != 120 {
+		t.Errorf("expected 120 members, got %d", len(members))
+	}
+
+	var pages int
+	for _, request := range server.Requests() {
+		if strings.HasSuffix(request.Path, "/members") {
+			pages++
+		}
+	}
+
+	// three pages of members, and an empty one to end the pagination
+	if pages != 4 {
+		t.Errorf("expected 4 requests for group members, got %d", pages)
+	}
+}
+
+func TestOfflineAuthenticationExecutions(t *testing.T) {
+	keycloakClient, _ := newOfflineKeycloakClient(t)
+
+	flow := &AuthenticationFlow{RealmId: keycloaktest.Realm, Alias: "custom", ProviderId: "basic-flow", TopLevel: true}
+	if err := keycloakClient.NewAuthenticationFlow(testCtx, flow); err != nil {
+		t.Fatalf("error creating flow: %s", err)
+	}
+
+	subFlow := &AuthenticationSubFlow{RealmId: keycloaktest.Realm, ParentFlowAlias: "custom", Alias: "forms", ProviderId: "basic-flow", Authenticator: "", Requirement: "ALTERNATIVE"}
+	if err := keycloakClient.NewAuthenticationSubFlow(testCtx, subFlow); err != nil {
+		t.Fatalf("error creating sub flow: %s", err)
+	}
+
+	execution := &AuthenticationExecution{RealmId: keycloaktest.Realm, ParentFlowAlias: "forms", Authenticator: "auth-username-password-form", Requirement: "REQUIRED"}
+	if err := keycloakClient.NewAuthenticationExecution(testCtx, execution); err != nil {
+		t.Fatalf("error creating execution: %s", err)
+	}
+
+	executions, err := keycloakClient.ListAuthenticationExecutions(testCtx, keycloaktest.Realm, "custom")
+	if err != nil {
+		t.Fatalf("error listing executions: %s", err)
+	}
+
+	if len(executions) != 2 || executions[1].ProviderId != "auth-username-password-form" || executions[1].Level != 1 || executions[1].Requirement != "REQUIRED" {
+		t.Errorf("expected the execution nested in the sub flow, got %+v", executions)
+	}
+}
+
+func TestOfflineRealmLocalization(t *testing.T) {
+	keycloakClient, server := newOfflineKeycloakClient(t)
+
+	realm := &Realm{Id: "localized", Realm: "localized", Enabled: true, InternationalizationEnabled: true, SupportLocales: []string{"en", "de"}, DefaultLocale: "en"}
+	if err := keycloakClient.NewRealm(testCtx, realm); err != nil {
+		t.Fatalf("error creating realm: %s", err)
+	}
+
+	localization := &RealmLocalization{RealmId: "localized", Locale: "fr", Texts: map[string]string{}}
+	if err := keycloakClient.ValidateRealmLocalization(testCtx, localization); err == nil || !strings.Contains(err.Error(), "en, de") {
+		t.Errorf("expected a locale that isn't supported to be rejected, got %v", err)
+	}
+
+	localization.Locale = "de"
+	localization.Texts = map[string]string{"loginTitle": "Anmelden", "doLogIn": "Einloggen"}
+	if err := keycloakClient.ValidateRealmLocalization(testCtx, localization); err != nil {
+		t.Fatalf("expected a supported locale to be accepted, got %s", err)
+	}
+	if err := keycloakClient.UpdateRealmLocalization(testCtx, localization); err != nil {
+		t.Fatalf("error updating localization: %s", err)
+	}
+
+	// texts that are no longer configured are removed, and unchanged ones aren't sent again
+	localization.Texts = map[string]string{"loginTitle": "Anmelden", "doRegister": "Registrieren"}
+	before := len(server.Requests())
+	if err := keycloakClient.UpdateRealmLocalization(testCtx, localization); err != nil {
+		t.Fatalf("error updating localization: %s", err)
+	}
+
+	var methods []string
+	for _, request := range server.Requests()[before:] {
+		methods = append(methods, request.Method)
+	}
+	if strings.Join(methods, " ") != "GET DELETE PUT" {
+		t.Errorf("expected the texts to be read, one to be deleted and one to be added, got %v", methods)
+	}
+
+	read, err := keycloakClient.GetRealmLocalization(testCtx, "localized", "de")
+	if err != nil {
+		t.Fatalf("error getting localization: %s", err)
+	}
+	if len(read.Texts) != 2 || read.Texts["loginTitle"] != "Anmelden" || read.Texts["doRegister"] != "Registrieren" {
+		t.Errorf("unexpected texts %v", read.Texts)
+	}
+
+	if err := keycloakClient.DeleteRealmLocalization(testCtx, "localized", "de"); err != nil {
+		t.Fatalf("error deleting localization: %s", err)
+	}
+	// deleting a locale without texts succeeds
+	if err := keycloakClient.DeleteRealmLocalization(testCtx, "localized", "de"); err != nil {
+		t.Errorf("expected deleting a locale without texts to succeed, got %s", err)
+	}
+}
+
+func TestOfflineRealmLocalizationWithoutInitialLogin(t *testing.T) {
+	keycloakClient, server := newOfflineKeycloakClient(t)
+
+	realm := &Realm{Id: "localized", Realm: "localized", Enabled: true, InternationalizationEnabled: true, SupportLocales: []string{"en", "de"}, DefaultLocale: "en"}
+	if err := keycloakClient.NewRealm(testCtx, realm); err != nil {
+		t.Fatalf("error creating realm: %s", err)
+	}
+
+	// the version isn't known before the first request when the client doesn't log in right away
+	lazyClient, err := NewKeycloakClient(testCtx, server.URL, "", keycloaktest.ClientId, keycloaktest.ClientSecret, keycloaktest.Realm, "", "", false, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	localization := &RealmLocalization{RealmId: "localized", Locale: "de", Texts: map[string]string{"loginTitle": "Anmelden"}}
+	if err := lazyClient.ValidateRealmLocalization(testCtx, localization); err != nil {
+		t.Errorf("expected a supported locale to be accepted before the client logged in, got %s", err)
+	}
+}
+
+func TestOfflineRealmClientPolicyProfiles(t *testing.T) {
+	keycloakClient, _ := newOfflineKeycloakClient(t)
+
+	if err := keycloakClient.NewRealm(testCtx, &Realm{Id: "policies", Realm: "policies", Enabled: true}); err != nil {
+		t.Fatalf("error creating realm: %s", err)
+	}
+
+	// profiles created at the same time don't overwrite each other, even though they are written as a whole
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- keycloakClient.NewRealmClientPolicyProfile(testCtx, &RealmClientPolicyProfile{
+				RealmId: "policies",
+				Name:    fmt.Sprintf("profile-%d", i),
+				Executors: []*RealmClientPolicyProfileExecutor{
+					{Executor: "pkce-enforcer", Configuration: map[string]interface{}{"auto-configure": "true"}},
+				},
+			})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("error creating profile: %s", err)
+		}
+	}
+
+	profiles, err := keycloakClient.getRealmClientPolicyProfiles(testCtx, "policies")
+	if err != nil {
+		t.Fatalf("error getting profiles: %s", err)
+	}
+	if len(profiles) != 10 {
+		t.Errorf("expected 10 profiles, got %d", len(profiles))
+	}
+
+	err = keycloakClient.NewRealmClientPolicyProfile(testCtx, &RealmClientPolicyProfile{RealmId: "policies", Name: "profile-1"})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected a duplicate profile to be rejected, got %v", err)
+	}
+
+	policy := &RealmClientPolicy{
+		RealmId:    "policies",
+		Name:       "policy",
+		Enabled:    true,
+		Conditions: []*RealmClientPolicyCondition{{Condition: "any-client", Configuration: map[string]interface{}{}}},
+		Profiles:   []string{"profile-1"},
+	}
+	if err := keycloakClient.NewRealmClientPolicy(testCtx, policy); err != nil {
+		t.Fatalf("error creating policy: %s", err)
+	}
+
+	if err := keycloakClient.DeleteRealmClientPolicyProfile(testCtx, "policies", "profile-3"); err != nil {
+		t.Fatalf("error deleting profile: %s", err)
+	}
+	if _, err := keycloakClient.GetRealmClientPolicyProfile(testCtx, "policies", "profile-3"); !ErrorIs404(err) {
+		t.Errorf("expected a 404 for the deleted profile, got %v", err)
+	}
+
+	read, err := keycloakClient.GetRealmClientPolicy(testCtx, "policies", "policy")
+	if err != nil {
+		t.Fatalf("error getting policy: %s", err)
+	}
+	if read.RealmId != "policies" || len(read.Conditions) != 1 || read.Conditions[0].Condition != "any-client" || len(read.Profiles) != 1 {
+		t.Errorf("unexpected policy %+v", read)
+	}
+}
+
+func TestOfflineDefaultRealmUserProfile(t *testing.T) {
+	for serverVersion, expectProhibitedCharacters := range map[string]bool{
+		"21.1.2": false,
+		"24.0.5": true,
+	} {
+		keycloakClient, _ := newOfflineKeycloakClient(t, keycloaktest.WithServerVersion(serverVersion))
+
+		for _, attribute := range keycloakClient.DefaultRealmUserProfile().Attributes {
+			var validator string
+			switch attribute.Name {
+			case "username":
+				validator = "username-prohibited-characters"
+			case "firstName", "lastName":
+				validator = "person-name-prohibited-characters"
+			default:
+				continue
+			}
+
+			if _, ok := attribute.Validations[validator]; ok != expectProhibitedCharacters {
+				t.Errorf("expected the %s validator of %s to be included for Keycloak %s: %t, got %t", validator, attribute.Name, serverVersion, expectProhibitedCharacters, ok)
+			}
+		}
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"log"
 	"net/http"
 	"net/http/cookiejar"
+	"net/http/httptest"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -53,9 +55,9 @@ func TestAccKeycloakApiClientRefresh(t *testing.T) {
 		t.Fatal("KEYCLOAK_CLIENT_TIMEOUT must be an integer")
 	}
 
-	keycloakClient, err := NewKeycloakClient(os.Getenv("KEYCLOAK_URL"), "/auth", os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), os.Getenv("KEYCLOAK_USER"), os.Getenv("KEYCLOAK_PASSWORD"), true, clientTimeout, "", false, "", map[string]string{
+	keycloakClient, err := NewKeycloakClient(testCtx, os.Getenv("KEYCLOAK_URL"), os.Getenv("KEYCLOAK_BASE_PATH"), os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), os.Getenv("KEYCLOAK_USER"), os.Getenv("KEYCLOAK_PASSWORD"), true, clientTimeout, TransportOptions{}, "", map[string]string{
 		"foo": "bar",
-	})
+	}, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
 	if err != nil {
 		t.Fatalf("%s", err)
 	}
@@ -71,18 +73,18 @@ func TestAccKeycloakApiClientRefresh(t *testing.T) {
 		Id:    realmName,
 	}
 
-	err = keycloakClient.NewRealm(realm)
+	err = keycloakClient.NewRealm(testCtx, realm)
 	if err != nil {
 		t.Fatalf("%s", err)
 	}
 
-	_, err = keycloakClient.GetRealm(realmName) // This should not fail since it will automatically refresh and try again
+	_, err = keycloakClient.GetRealm(testCtx, realmName) // This should not fail since it will automatically refresh and try again
 	if err != nil {
 		t.Fatalf("%s", err)
 	}
 
 	// Clean up - the realm doesn't need to exist in order for us to assert against the refreshed tokens
-	err = keycloakClient.DeleteRealm(realmName)
+	err = keycloakClient.DeleteRealm(testCtx, realmName)
 	if err != nil {
 		t.Fatalf("%s", err)
 	}
@@ -127,7 +129,83 @@ func TestKeycloakApiClientRefresh(t *testing.T) {
 		additionalHeaders: nil,
 	}
 
-	r, e := keycloakClient.GetIdentityProviderMapper("test", "oidc", "7718c4d0-5c6b-4b1b-9a83-fb40aa2746a6")
+	r, e := keycloakClient.GetIdentityProviderMapper(testCtx, "test", "oidc", "7718c4d0-5c6b-4b1b-9a83-fb40aa2746a6")
 	log.Println(r, e)
-	keycloakClient.UpdateIdentityProviderMapper(r)
+	keycloakClient.UpdateIdentityProviderMapper(testCtx, r)
+}
+
+func newBasePathTestServer(t *testing.T, basePath string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(basePath+"/realms/master/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issuer": "issuer"}`))
+	})
+	mux.HandleFunc(basePath+"/realms/master/protocol/openid-connect/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "access", "refresh_token": "refresh", "token_type": "bearer"}`))
+	})
+	mux.HandleFunc(basePath+"/admin/serverinfo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"systemInfo": {"version": "26.0.5"}}`))
+	})
+	mux.HandleFunc(basePath+"/admin/realms/test", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "test", "realm": "test"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestBasePathDetection(t *testing.T) {
+	for _, basePath := range []string{"/auth", ""} {
+		for _, initialLogin := range []bool{true, false} {
+			server := newBasePathTestServer(t, basePath)
+
+			keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "", "admin-cli", "secret", "master", "", "", initialLogin, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var realm Realm
+			err = keycloakClient.get(testCtx, "/realms/test", &realm, nil)
+			if err != nil {
+				t.Fatalf("unexpected error for base path %q: %s", basePath, err)
+			}
+			if realm.Realm != "test" {
+				t.Errorf("expected realm test, got %s", realm.Realm)
+			}
+			if keycloakClient.getBaseUrl() != server.URL+basePath {
+				t.Errorf("expected base path %q to be detected, got %s", basePath, keycloakClient.getBaseUrl())
+			}
+			if !keycloakClient.VersionIsGreaterThanOrEqualTo(Version_26) {
+				t.Error("expected server version to be fetched from the detected base path")
+			}
+		}
+	}
+}
+
+func TestBasePathDetectionFailure(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	_, err := NewKeycloakClient(testCtx, server.URL, "", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
+	if err == nil || !strings.Contains(err.Error(), "unable to detect the base path") {
+		t.Fatalf("expected base path detection to fail, got %v", err)
+	}
+}
+
+func TestExplicitRootBasePath(t *testing.T) {
+	server := newBasePathTestServer(t, "")
+
+	keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "/", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if keycloakClient.detectBasePath || keycloakClient.getBaseUrl() != server.URL {
+		t.Errorf("expected root base path to be used without detection, got %s", keycloakClient.getBaseUrl())
+	}
 }
@@ -1,10 +1,13 @@
 package keycloak
 
 import (
+	"context"
 	"log"
 	"os"
 )
 
+var testCtx = context.Background()
+
 var requiredEnvironmentVariables = []string{
 	"KEYCLOAK_CLIENT_ID",
 	"KEYCLOAK_CLIENT_SECRET",
@@ -0,0 +1,1347 @@
+package keycloaktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Matches the path segments against a pattern such as "clients/*/roles" or "default-*-client-scopes", returning the
+// parts matched by wildcards. A wildcard matches within a single segment.
+func match(segments []string, pattern string) ([]string, bool) {
+	patternSegments := strings.Split(pattern, "/")
+	if len(segments) != len(patternSegments) {
+		return nil, false
+	}
+
+	var params []string
+	for i, patternSegment := range patternSegments {
+		if !strings.Contains(patternSegment, "*") {
+			if patternSegment != segments[i] {
+				return nil, false
+			}
+
+			continue
+		}
+
+		parts := strings.SplitN(patternSegment, "*", 2)
+		prefix, suffix := parts[0], parts[1]
+		if len(segments[i]) <= len(prefix)+len(suffix) || !strings.HasPrefix(segments[i], prefix) || !strings.HasSuffix(segments[i], suffix) {
+			return nil, false
+		}
+
+		params = append(params, segments[i][len(prefix):len(segments[i])-len(suffix)])
+	}
+
+	return params, true
+}
+
+func (server *Server) admin(w http.ResponseWriter, r *http.Request, segments []string) {
+	if _, ok := match(segments, "serverinfo"); ok && r.Method == http.MethodGet {
+		server.serverInfo(w)
+		return
+	}
+
+	if _, ok := match(segments, "realms"); ok {
+		server.realmCollection(w, r)
+		return
+	}
+
+	if len(segments) < 2 || segments[0] != "realms" {
+		writeError(w, http.StatusNotFound, "")
+		return
+	}
+
+	realm, ok := server.realms[segments[1]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Realm not found.")
+		return
+	}
+
+	if len(segments) == 2 {
+		server.realmResource(w, r, realm)
+		return
+	}
+
+	handler := realmHandler{
+		realm:    realm,
+		w:        w,
+		r:        r,
+		segments: segments[2:],
+	}
+	handler.serve()
+}
+
+func (server *Server) serverInfo(w http.ResponseWriter) {
+	themes := object{}
+	for _, themeType := range []string{"login", "account", "admin", "email", "welcome", "common"} {
+		themes[themeType] = []object{{"name": "base"}, {"name": "keycloak"}}
+	}
+
+	providers := func(names ...string) object {
+		result := object{}
+		for _, name := range names {
+			result[name] = object{}
+		}
+
+		return object{"internal": false, "providers": result}
+	}
+
+	writeJson(w, http.StatusOK, object{
+		"systemInfo": object{"version": server.serverVersion},
+		"themes":     themes,
+		"componentTypes": object{
+			"org.keycloak.storage.UserStorageProvider": []object{{"id": "ldap"}, {"id": "kerberos"}},
+			"org.keycloak.keys.KeyProvider":            []object{{"id": "rsa-generated"}, {"id": "hmac-generated"}, {"id": "aes-generated"}},
+		},
+		"providers": object{
+			"password-policy": providers("length", "digits", "lowerCase", "upperCase", "specialChars", "notUsername", "notEmail", "passwordHistory", "forceExpiredPasswordChange", "hashAlgorithm", "hashIterations", "regexPattern", "passwordBlacklist"),
+			"required-action": providers("CONFIGURE_TOTP", "terms_and_conditions", "UPDATE_PASSWORD", "UPDATE_PROFILE", "VERIFY_EMAIL"),
+		},
+	})
+}
+
+func (server *Server) realmCollection(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		realms := []object{}
+		for _, name := range server.realmNames {
+			realms = append(realms, server.realms[name].representation)
+		}
+
+		writeJson(w, http.StatusOK, realms)
+	case http.MethodPost:
+		representation, ok := decode(w, r)
+		if !ok {
+			return
+		}
+
+		name, _ := representation["realm"].(string)
+		if name == "" {
+			writeError(w, http.StatusBadRequest, "Realm name cannot be empty")
+			return
+		}
+		if _, exists := server.realms[name]; exists {
+			writeError(w, http.StatusConflict, "Conflict detected. See logs for details")
+			return
+		}
+
+		server.createRealm(representation)
+		created(w, r, name)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (server *Server) realmResource(w http.ResponseWriter, r *http.Request, realm *realm) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJson(w, http.StatusOK, realm.representation)
+	case http.MethodPut:
+		representation, ok := decode(w, r)
+		if !ok {
+			return
+		}
+
+		// like Keycloak, attributes that aren't sent are kept
+		if attributes, ok := representation["attributes"].(map[string]interface{}); ok {
+			if current, ok := realm.representation["attributes"].(map[string]interface{}); ok {
+				for key, value := range current {
+					if _, ok := attributes[key]; !ok {
+						attributes[key] = value
+					}
+				}
+			}
+		}
+
+		for key, value := range representation {
+			realm.representation[key] = value
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		server.deleteRealm(realm.representation["realm"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+// Serves a single request for resources within a realm
+type realmHandler struct {
+	realm    *realm
+	w        http.ResponseWriter
+	r        *http.Request
+	segments []string
+}
+
+func (h *realmHandler) route(pattern string, methods ...string) ([]string, bool) {
+	params, ok := match(h.segments, pattern)
+	if !ok {
+		return nil, false
+	}
+
+	for _, method := range methods {
+		if method == h.r.Method {
+			return params, true
+		}
+	}
+
+	return nil, false
+}
+
+func (h *realmHandler) serve() {
+	if _, ok := h.route("clients", http.MethodGet, http.MethodPost); ok {
+		h.collection("clients", nil, h.filter("clientId"))
+	} else if params, ok := h.route("clients/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.resource("clients", params[0])
+	} else if params, ok := h.route("clients/*/client-secret", http.MethodGet, http.MethodPost); ok {
+		h.clientSecret(params[0])
+	} else if params, ok := h.route("clients/*/service-account-user", http.MethodGet); ok {
+		h.serviceAccountUser(params[0])
+	} else if params, ok := h.route("clients/*/roles", http.MethodGet, http.MethodPost); ok {
+		h.nestedCollection("clients", params[0], "roles", h.roleDefaults(params[0]))
+	} else if params, ok := h.route("clients/*/roles/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.roleByName("clients/"+params[0]+"/roles", params[1])
+	} else if _, ok := h.route("clients/*/roles/*/users", http.MethodGet); ok {
+		writeJson(h.w, http.StatusOK, []object{})
+	} else if params, ok := h.route("clients/*/*-client-scopes", http.MethodGet); ok {
+		h.clientScopeAssignments(params[0], params[1], "")
+	} else if params, ok := h.route("clients/*/*-client-scopes/*", http.MethodPut, http.MethodDelete); ok {
+		h.clientScopeAssignments(params[0], params[1], params[2])
+	} else if params, ok := h.route("clients/*/protocol-mappers/models", http.MethodGet, http.MethodPost); ok {
+		h.nestedCollection("clients", params[0], "protocol-mappers/models", nil)
+	} else if params, ok := h.route("clients/*/protocol-mappers/models/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.resource("clients/"+params[0]+"/protocol-mappers/models", params[1])
+	} else if _, ok := h.route("client-scopes", http.MethodGet, http.MethodPost); ok {
+		h.collection("client-scopes", nil, nil)
+	} else if params, ok := h.route("client-scopes/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.resource("client-scopes", params[0])
+	} else if params, ok := h.route("client-scopes/*/protocol-mappers/models", http.MethodGet, http.MethodPost); ok {
+		h.nestedCollection("client-scopes", params[0], "protocol-mappers/models", nil)
+	} else if params, ok := h.route("client-scopes/*/protocol-mappers/models/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.resource("client-scopes/"+params[0]+"/protocol-mappers/models", params[1])
+	} else if params, ok := h.route("default-*-client-scopes", http.MethodGet); ok {
+		h.defaultClientScopes(params[0], "")
+	} else if params, ok := h.route("default-*-client-scopes/*", http.MethodPut, http.MethodDelete); ok {
+		h.defaultClientScopes(params[0], params[1])
+	} else if _, ok := h.route("roles", http.MethodGet, http.MethodPost); ok {
+		h.collection("roles", h.roleDefaults(""), nil)
+	} else if params, ok := h.route("roles/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.roleByName("roles", params[0])
+	} else if params, ok := h.route("roles-by-id/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.resource("", params[0])
+	} else if params, ok := h.route("roles-by-id/*/composites", http.MethodGet, http.MethodPost, http.MethodDelete); ok {
+		h.composites(params[0])
+	} else if _, ok := h.route("groups", http.MethodGet); ok {
+		h.groups()
+	} else if _, ok := h.route("groups", http.MethodPost); ok {
+		h.create("groups", nil)
+	} else if params, ok := h.route("groups/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.group(params[0])
+	} else if params, ok := h.route("groups/*/children", http.MethodGet, http.MethodPost); ok {
+		h.nestedCollection("groups", params[0], "children", nil)
+	} else if params, ok := h.route("groups/*/members", http.MethodGet); ok {
+		h.groupMembers(params[0])
+	} else if _, ok := h.route("default-groups", http.MethodGet); ok {
+		h.writeObjects(h.objects(h.realm.defaultGroups))
+	} else if params, ok := h.route("default-groups/*", http.MethodPut, http.MethodDelete); ok {
+		h.defaultGroup(params[0])
+	} else if _, ok := h.route("users", http.MethodGet); ok {
+		h.users(false)
+	} else if _, ok := h.route("users/count", http.MethodGet); ok {
+		h.users(true)
+	} else if _, ok := h.route("users/profile", http.MethodGet, http.MethodPut); ok {
+		h.userProfile()
+	} else if _, ok := h.route("users", http.MethodPost); ok {
+		h.createUser()
+	} else if params, ok := h.route("users/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.resource("users", params[0])
+	} else if params, ok := h.route("users/*/groups", http.MethodGet); ok {
+		h.userGroups(params[0])
+	} else if params, ok := h.route("users/*/groups/*", http.MethodPut, http.MethodDelete); ok {
+		h.groupMembership(params[0], params[1])
+	} else if params, ok := h.route("users/*/reset-password", http.MethodPut); ok {
+		h.resetPassword(params[0])
+	} else if params, ok := h.route("users/*/federated-identity", http.MethodGet); ok {
+		h.writeObjects(h.realm.collections["users/"+params[0]+"/federated-identity"])
+	} else if params, ok := h.route("users/*/federated-identity/*", http.MethodPost, http.MethodDelete); ok {
+		h.federatedIdentity(params[0], params[1])
+	} else if _, ok := h.route("components", http.MethodGet, http.MethodPost); ok {
+		h.collection("components", nil, h.filter("parent:parentId", "type:providerType", "name"))
+	} else if params, ok := h.route("components/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.resource("components", params[0])
+	} else if _, ok := h.route("authentication/flows", http.MethodGet); ok {
+		h.flows()
+	} else if _, ok := h.route("authentication/flows", http.MethodPost); ok {
+		h.create("authentication/flows", object{"topLevel": true, "builtIn": false})
+	} else if params, ok := h.route("authentication/flows/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.resource("authentication/flows", params[0])
+	} else if params, ok := h.route("authentication/flows/*/executions", http.MethodGet, http.MethodPut); ok {
+		h.flowExecutions(params[0])
+	} else if params, ok := h.route("authentication/flows/*/executions/*", http.MethodPost); ok {
+		h.createExecution(params[0], params[1])
+	} else if params, ok := h.route("authentication/executions/*", http.MethodGet, http.MethodDelete); ok {
+		h.execution(params[0])
+	} else if params, ok := h.route("authentication/executions/*/*-priority", http.MethodPost); ok {
+		h.moveExecution(params[0], params[1])
+	} else if params, ok := h.route("localization/*", http.MethodGet, http.MethodDelete); ok {
+		h.localization(params[0], "")
+	} else if params, ok := h.route("localization/*/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.localization(params[0], params[1])
+	} else if _, ok := h.route("identity-provider/import-config", http.MethodPost); ok {
+		h.importIdentityProviderConfig()
+	} else if _, ok := h.route("identity-provider/instances", http.MethodGet, http.MethodPost); ok {
+		h.identityProviders()
+	} else if params, ok := h.route("identity-provider/instances/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.identityProvider(params[0])
+	} else if params, ok := h.route("identity-provider/instances/*/mappers", http.MethodGet, http.MethodPost); ok {
+		h.identityProviderMappers(params[0])
+	} else if params, ok := h.route("identity-provider/instances/*/mappers/*", http.MethodGet, http.MethodPut, http.MethodDelete); ok {
+		h.identityProviderMapper(params[0], params[1])
+	} else if params, ok := h.route("client-policies/*", http.MethodGet, http.MethodPut); ok {
+		h.clientPolicies(params[0])
+	} else if _, ok := h.route("keys", http.MethodGet); ok {
+		writeJson(h.w, http.StatusOK, object{"active": object{}, "keys": []object{}})
+	} else {
+		writeError(h.w, http.StatusNotFound, "")
+	}
+}
+
+// A filter matches objects against the query parameters of a list request
+type filter func(o object, query url.Values) bool
+
+// Returns a filter for exact matches of query parameters, which may be mapped to a different attribute with
+// "parameter:attribute"
+func (h *realmHandler) filter(parameters ...string) filter {
+	return func(o object, query url.Values) bool {
+		for _, parameter := range parameters {
+			attribute := parameter
+			if parts := strings.SplitN(parameter, ":", 2); len(parts) == 2 {
+				parameter, attribute = parts[0], parts[1]
+			}
+
+			if value := query.Get(parameter); value != "" && fmt.Sprint(o[attribute]) != value {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+func (h *realmHandler) collection(collection string, defaults object, f filter) {
+	if h.r.Method == http.MethodPost {
+		h.create(collection, defaults)
+		return
+	}
+
+	query := h.r.URL.Query()
+
+	objects := []object{}
+	for _, o := range h.realm.collections[collection] {
+		if f == nil || f(o, query) {
+			objects = append(objects, o)
+		}
+	}
+
+	h.writeObjects(paginate(objects, query))
+}
+
+func (h *realmHandler) nestedCollection(parentCollection, parentId, collection string, defaults object) {
+	if h.realm.get(parentCollection, parentId) == nil && !(parentCollection == "groups" && h.realm.get("", parentId) != nil) {
+		writeError(h.w, http.StatusNotFound, "Could not find parent")
+		return
+	}
+
+	nested := parentCollection + "/" + parentId + "/" + collection
+	if h.r.Method == http.MethodPost {
+		h.create(nested, defaults)
+		return
+	}
+
+	h.writeObjects(h.realm.collections[nested])
+}
+
+func (h *realmHandler) create(collection string, defaults object) {
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	for key, value := range defaults {
+		if _, ok := representation[key]; !ok {
+			representation[key] = value
+		}
+	}
+
+	o, conflict := h.realm.add(collection, representation)
+	if conflict != "" {
+		writeError(h.w, http.StatusConflict, conflict)
+		return
+	}
+
+	created(h.w, h.r, o["id"].(string))
+}
+
+func (h *realmHandler) resource(collection, id string) {
+	o := h.realm.get(collection, id)
+	if o == nil {
+		writeError(h.w, http.StatusNotFound, "Could not find resource")
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		writeJson(h.w, http.StatusOK, o)
+	case http.MethodPut:
+		representation, ok := decode(h.w, h.r)
+		if !ok {
+			return
+		}
+
+		if conflict := h.realm.update(id, representation); conflict != "" {
+			writeError(h.w, http.StatusConflict, conflict)
+			return
+		}
+
+		h.w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		h.realm.remove(id)
+		h.w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (h *realmHandler) writeObjects(objects []object) {
+	if objects == nil {
+		objects = []object{}
+	}
+
+	writeJson(h.w, http.StatusOK, objects)
+}
+
+func (h *realmHandler) objects(ids []string) []object {
+	objects := []object{}
+	for _, id := range ids {
+		if o := h.realm.get("", id); o != nil {
+			objects = append(objects, o)
+		}
+	}
+
+	return objects
+}
+
+func (h *realmHandler) clientSecret(clientId string) {
+	client := h.realm.get("clients", clientId)
+	if client == nil {
+		writeError(h.w, http.StatusNotFound, "Could not find client")
+		return
+	}
+
+	if _, ok := client["secret"]; !ok || h.r.Method == http.MethodPost {
+		client["secret"] = newId()
+	}
+
+	writeJson(h.w, http.StatusOK, object{"type": "secret", "value": client["secret"]})
+}
+
+func (h *realmHandler) serviceAccountUser(clientId string) {
+	client := h.realm.get("clients", clientId)
+	if client == nil {
+		writeError(h.w, http.StatusNotFound, "Could not find client")
+		return
+	}
+	if client["serviceAccountsEnabled"] != true {
+		writeError(h.w, http.StatusBadRequest, "Service account not enabled for the client '"+fmt.Sprint(client["clientId"])+"'")
+		return
+	}
+
+	username := "service-account-" + strings.ToLower(fmt.Sprint(client["clientId"]))
+	user := h.realm.find("users", "username", username)
+	if user == nil {
+		user, _ = h.realm.add("users", object{"username": username, "enabled": true, "serviceAccountClientId": client["clientId"]})
+	}
+
+	writeJson(h.w, http.StatusOK, user)
+}
+
+func (h *realmHandler) roleDefaults(clientId string) object {
+	if clientId == "" {
+		return object{"composite": false, "clientRole": false, "containerId": h.realm.representation["id"]}
+	}
+
+	return object{"composite": false, "clientRole": true, "containerId": clientId}
+}
+
+func (h *realmHandler) roleByName(collection, name string) {
+	role := h.realm.find(collection, "name", name)
+	if role == nil {
+		writeError(h.w, http.StatusNotFound, "Could not find role")
+		return
+	}
+
+	h.resource(collection, role["id"].(string))
+}
+
+func (h *realmHandler) composites(roleId string) {
+	role := h.realm.get("", roleId)
+	if role == nil {
+		writeError(h.w, http.StatusNotFound, "Could not find role")
+		return
+	}
+
+	if h.r.Method == http.MethodGet {
+		h.writeObjects(h.objects(h.realm.composites[roleId]))
+		return
+	}
+
+	var roles []object
+	if err := json.NewDecoder(h.r.Body).Decode(&roles); err != nil {
+		writeError(h.w, http.StatusBadRequest, "Invalid representation")
+		return
+	}
+
+	for _, composite := range roles {
+		id, _ := composite["id"].(string)
+		if h.r.Method == http.MethodPost && !containsString(h.realm.composites[roleId], id) {
+			h.realm.composites[roleId] = append(h.realm.composites[roleId], id)
+		} else if h.r.Method == http.MethodDelete {
+			h.realm.composites[roleId] = removeString(h.realm.composites[roleId], id)
+		}
+	}
+
+	role["composite"] = len(h.realm.composites[roleId]) != 0
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *realmHandler) clientScopeAssignments(clientId, scopeType, scopeId string) {
+	if h.realm.get("clients", clientId) == nil || (scopeType != "default" && scopeType != "optional") {
+		writeError(h.w, http.StatusNotFound, "Could not find client")
+		return
+	}
+
+	if h.realm.clientScopes[clientId] == nil {
+		h.realm.clientScopes[clientId] = map[string][]string{}
+	}
+	assignments := h.realm.clientScopes[clientId]
+
+	switch h.r.Method {
+	case http.MethodGet:
+		scopes := []object{}
+		for _, scope := range h.objects(assignments[scopeType]) {
+			scopes = append(scopes, object{"id": scope["id"], "name": scope["name"]})
+		}
+
+		writeJson(h.w, http.StatusOK, scopes)
+	case http.MethodPut:
+		if h.realm.get("client-scopes", scopeId) == nil {
+			writeError(h.w, http.StatusNotFound, "Could not find client scope")
+			return
+		}
+		if !containsString(assignments[scopeType], scopeId) {
+			assignments[scopeType] = append(assignments[scopeType], scopeId)
+		}
+
+		h.w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		assignments[scopeType] = removeString(assignments[scopeType], scopeId)
+		h.w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (h *realmHandler) defaultClientScopes(scopeType, scopeId string) {
+	if scopeType != "default" && scopeType != "optional" {
+		writeError(h.w, http.StatusNotFound, "")
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		scopes := []object{}
+		for _, scope := range h.objects(h.realm.defaultClientScopes[scopeType]) {
+			scopes = append(scopes, object{"id": scope["id"], "name": scope["name"]})
+		}
+
+		writeJson(h.w, http.StatusOK, scopes)
+	case http.MethodPut:
+		if h.realm.get("client-scopes", scopeId) == nil {
+			writeError(h.w, http.StatusNotFound, "Client scope not found")
+			return
+		}
+		if !containsString(h.realm.defaultClientScopes[scopeType], scopeId) {
+			h.realm.defaultClientScopes[scopeType] = append(h.realm.defaultClientScopes[scopeType], scopeId)
+		}
+
+		h.w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		h.realm.defaultClientScopes[scopeType] = removeString(h.realm.defaultClientScopes[scopeType], scopeId)
+		h.w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Returns the group with its path and sub groups, as Keycloak does
+func (h *realmHandler) groupRepresentation(group object, path string) object {
+	representation := object{}
+	for key, value := range group {
+		representation[key] = value
+	}
+
+	representation["path"] = path + "/" + fmt.Sprint(group["name"])
+
+	subGroups := []object{}
+	for _, child := range h.realm.collections["groups/"+group["id"].(string)+"/children"] {
+		subGroups = append(subGroups, h.groupRepresentation(child, representation["path"].(string)))
+	}
+	representation["subGroups"] = subGroups
+
+	return representation
+}
+
+// Returns the path of the group's parent
+func (h *realmHandler) groupParentPath(id string) string {
+	e, ok := h.realm.entries[id]
+	if !ok || e.collection == "groups" {
+		return ""
+	}
+
+	parentId := strings.Split(e.collection, "/")[1]
+	parent := h.realm.get("", parentId)
+
+	return h.groupParentPath(parentId) + "/" + fmt.Sprint(parent["name"])
+}
+
+// Searching groups returns the top level groups containing a match, with their sub groups limited to the matches
+func searchGroups(groups []object, search string) []object {
+	result := []object{}
+	for _, group := range groups {
+		subGroups := searchGroups(group["subGroups"].([]object), search)
+		if strings.Contains(strings.ToLower(fmt.Sprint(group["name"])), strings.ToLower(search)) || len(subGroups) != 0 {
+			group["subGroups"] = subGroups
+			result = append(result, group)
+		}
+	}
+
+	return result
+}
+
+func (h *realmHandler) groups() {
+	query := h.r.URL.Query()
+
+	groups := []object{}
+	for _, group := range h.realm.collections["groups"] {
+		groups = append(groups, h.groupRepresentation(group, ""))
+	}
+
+	if search := query.Get("search"); search != "" {
+		groups = searchGroups(groups, search)
+	}
+
+	h.writeObjects(paginate(groups, query))
+}
+
+func (h *realmHandler) group(id string) {
+	e, ok := h.realm.entries[id]
+	if !ok || (e.collection != "groups" && collectionKind(e.collection) != "children") {
+		writeError(h.w, http.StatusNotFound, "Could not find group by id")
+		return
+	}
+
+	if h.r.Method == http.MethodGet {
+		writeJson(h.w, http.StatusOK, h.groupRepresentation(e.object, h.groupParentPath(id)))
+		return
+	}
+
+	if h.r.Method == http.MethodPut {
+		representation, ok := decode(h.w, h.r)
+		if !ok {
+			return
+		}
+
+		// sub groups and the path are derived from the hierarchy
+		delete(representation, "subGroups")
+		delete(representation, "path")
+
+		if conflict := h.realm.update(id, representation); conflict != "" {
+			writeError(h.w, http.StatusConflict, conflict)
+			return
+		}
+
+		h.w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	h.realm.remove(id)
+	h.realm.defaultGroups = removeString(h.realm.defaultGroups, id)
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *realmHandler) groupMembers(groupId string) {
+	if h.realm.get("", groupId) == nil {
+		writeError(h.w, http.StatusNotFound, "Could not find group by id")
+		return
+	}
+
+	h.writeObjects(paginate(h.objects(h.realm.groupMembers[groupId]), h.r.URL.Query()))
+}
+
+func (h *realmHandler) defaultGroup(groupId string) {
+	if h.realm.get("", groupId) == nil {
+		writeError(h.w, http.StatusNotFound, "Group not found")
+		return
+	}
+
+	h.realm.defaultGroups = removeString(h.realm.defaultGroups, groupId)
+	if h.r.Method == http.MethodPut {
+		h.realm.defaultGroups = append(h.realm.defaultGroups, groupId)
+	}
+
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+// Serves the texts of a locale, or a single text if a key is given. Single texts are sent as plain text.
+func (h *realmHandler) localization(locale, key string) {
+	texts := h.realm.localizations[locale]
+
+	if key == "" {
+		switch h.r.Method {
+		case http.MethodGet:
+			if texts == nil {
+				texts = map[string]string{}
+			}
+
+			writeJson(h.w, http.StatusOK, texts)
+		case http.MethodDelete:
+			if len(texts) == 0 {
+				writeError(h.w, http.StatusNotFound, "No localization texts for locale "+locale+" found.")
+				return
+			}
+
+			delete(h.realm.localizations, locale)
+			h.w.WriteHeader(http.StatusNoContent)
+		}
+
+		return
+	}
+
+	text, exists := texts[key]
+
+	switch h.r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(h.w, http.StatusNotFound, "Localization text not found")
+			return
+		}
+
+		h.w.Header().Set("Content-Type", "text/plain")
+		h.w.WriteHeader(http.StatusOK)
+		h.w.Write([]byte(text))
+	case http.MethodPut:
+		if !strings.HasPrefix(h.r.Header.Get("Content-Type"), "text/plain") {
+			writeError(h.w, http.StatusUnsupportedMediaType, "")
+			return
+		}
+
+		body, err := ioutil.ReadAll(h.r.Body)
+		if err != nil {
+			writeError(h.w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if texts == nil {
+			texts = map[string]string{}
+			h.realm.localizations[locale] = texts
+		}
+
+		texts[key] = string(body)
+		h.w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if !exists {
+			writeError(h.w, http.StatusNotFound, "Localization text not found")
+			return
+		}
+
+		delete(texts, key)
+		h.w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (h *realmHandler) userProfile() {
+	if h.r.Method == http.MethodGet {
+		writeJson(h.w, http.StatusOK, h.realm.userProfile)
+		return
+	}
+
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	h.realm.userProfile = representation
+	writeJson(h.w, http.StatusOK, representation)
+}
+
+// Identity providers are addressed by their alias rather than their id, which Keycloak calls internalId
+func (h *realmHandler) identityProviders() {
+	if h.r.Method == http.MethodGet {
+		h.writeObjects(h.realm.collections["identity-provider/instances"])
+		return
+	}
+
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	alias, _ := representation["alias"].(string)
+	if alias == "" {
+		writeError(h.w, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	o, conflict := h.realm.add("identity-provider/instances", representation)
+	if conflict != "" {
+		writeError(h.w, http.StatusConflict, conflict)
+		return
+	}
+	o["internalId"] = o["id"]
+
+	created(h.w, h.r, alias)
+}
+
+func (h *realmHandler) identityProviderId(alias string) (string, bool) {
+	identityProvider := h.realm.find("identity-provider/instances", "alias", alias)
+	if identityProvider == nil {
+		writeError(h.w, http.StatusNotFound, "Could not find identity provider")
+		return "", false
+	}
+
+	return identityProvider["id"].(string), true
+}
+
+func (h *realmHandler) identityProvider(alias string) {
+	id, ok := h.identityProviderId(alias)
+	if !ok {
+		return
+	}
+
+	if h.r.Method == http.MethodPut {
+		representation, ok := decode(h.w, h.r)
+		if !ok {
+			return
+		}
+
+		representation["internalId"] = id
+		if conflict := h.realm.update(id, representation); conflict != "" {
+			writeError(h.w, http.StatusConflict, conflict)
+			return
+		}
+
+		h.w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	h.resource("identity-provider/instances", id)
+}
+
+// Only OpenID Connect discovery documents are imported, which are fetched like Keycloak does
+func (h *realmHandler) importIdentityProviderConfig() {
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	providerId, _ := representation["providerId"].(string)
+	fromUrl, _ := representation["fromUrl"].(string)
+	if providerId != "oidc" && providerId != "keycloak-oidc" {
+		writeError(h.w, http.StatusBadRequest, "Invalid provider id")
+		return
+	}
+
+	response, err := http.Get(fromUrl)
+	if err != nil || response.StatusCode != http.StatusOK {
+		writeError(h.w, http.StatusBadRequest, "Failed to import config from "+fromUrl)
+		return
+	}
+	defer response.Body.Close()
+
+	var discovery map[string]interface{}
+	if err := json.NewDecoder(response.Body).Decode(&discovery); err != nil {
+		writeError(h.w, http.StatusBadRequest, "Failed to import config from "+fromUrl)
+		return
+	}
+
+	config := map[string]string{}
+	for key, endpoint := range map[string]string{
+		"issuer":           "issuer",
+		"authorizationUrl": "authorization_endpoint",
+		"tokenUrl":         "token_endpoint",
+		"userInfoUrl":      "userinfo_endpoint",
+		"logoutUrl":        "end_session_endpoint",
+	} {
+		if value, ok := discovery[endpoint].(string); ok {
+			config[key] = value
+		}
+	}
+	if jwksUrl, ok := discovery["jwks_uri"].(string); ok {
+		config["jwksUrl"] = jwksUrl
+		config["useJwksUrl"] = "true"
+		config["validateSignature"] = "true"
+	}
+
+	writeJson(h.w, http.StatusOK, config)
+}
+
+func (h *realmHandler) identityProviderMappers(alias string) {
+	id, ok := h.identityProviderId(alias)
+	if !ok {
+		return
+	}
+
+	h.nestedCollection("identity-provider/instances", id, "mappers", object{"identityProviderAlias": alias})
+}
+
+func (h *realmHandler) identityProviderMapper(alias, mapperId string) {
+	id, ok := h.identityProviderId(alias)
+	if !ok {
+		return
+	}
+
+	h.resource("identity-provider/instances/"+id+"/mappers", mapperId)
+}
+
+// Client policies and profiles are only read and replaced as a whole, and none are built in
+func (h *realmHandler) clientPolicies(kind string) {
+	current, ok := h.realm.clientPolicies[kind]
+	if !ok {
+		writeError(h.w, http.StatusNotFound, "")
+		return
+	}
+
+	if h.r.Method == http.MethodGet {
+		writeJson(h.w, http.StatusOK, object{kind: current})
+		return
+	}
+
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	if representation[kind] == nil {
+		representation[kind] = []object{}
+	}
+
+	h.realm.clientPolicies[kind] = representation[kind]
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *realmHandler) users(count bool) {
+	query := h.r.URL.Query()
+	exact := query.Get("exact") == "true"
+
+	matches := func(o object, attribute string) bool {
+		value := query.Get(attribute)
+		if value == "" {
+			return true
+		}
+
+		actual := strings.ToLower(fmt.Sprint(o[attribute]))
+		if exact {
+			return actual == strings.ToLower(value)
+		}
+
+		return strings.Contains(actual, strings.ToLower(value))
+	}
+
+	users := []object{}
+	for _, user := range h.realm.collections["users"] {
+		if !matches(user, "username") || !matches(user, "email") || !matches(user, "firstName") || !matches(user, "lastName") {
+			continue
+		}
+
+		if search := strings.ToLower(query.Get("search")); search != "" {
+			found := false
+			for _, attribute := range []string{"username", "email", "firstName", "lastName"} {
+				if value, ok := user[attribute].(string); ok && strings.Contains(strings.ToLower(value), search) {
+					found = true
+				}
+			}
+
+			if !found {
+				continue
+			}
+		}
+
+		users = append(users, user)
+	}
+
+	if count {
+		writeJson(h.w, http.StatusOK, len(users))
+		return
+	}
+
+	// like Keycloak, at most 100 users are returned unless more are requested
+	if query.Get("max") == "" {
+		query.Set("max", "100")
+	}
+
+	h.writeObjects(paginate(users, query))
+}
+
+func (h *realmHandler) createUser() {
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	username, _ := representation["username"].(string)
+	representation["username"] = strings.ToLower(username)
+
+	if email, _ := representation["email"].(string); email != "" && h.realm.representation["duplicateEmailsAllowed"] != true {
+		for _, user := range h.realm.collections["users"] {
+			if strings.EqualFold(fmt.Sprint(user["email"]), email) {
+				writeError(h.w, http.StatusConflict, "User exists with same email")
+				return
+			}
+		}
+	}
+
+	// credentials are write only
+	delete(representation, "credentials")
+
+	if _, ok := representation["createdTimestamp"]; !ok {
+		representation["createdTimestamp"] = 0
+	}
+
+	user, conflict := h.realm.add("users", representation)
+	if conflict != "" {
+		writeError(h.w, http.StatusConflict, conflict)
+		return
+	}
+
+	created(h.w, h.r, user["id"].(string))
+}
+
+func (h *realmHandler) userGroups(userId string) {
+	if h.realm.get("users", userId) == nil {
+		writeError(h.w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	var memberOf []object
+	for groupId, members := range h.realm.groupMembers {
+		if containsString(members, userId) {
+			group := h.realm.get("", groupId)
+			memberOf = append(memberOf, object{"id": groupId, "name": group["name"], "path": h.groupParentPath(groupId) + "/" + fmt.Sprint(group["name"])})
+		}
+	}
+
+	sort.Slice(memberOf, func(i, j int) bool {
+		return fmt.Sprint(memberOf[i]["path"]) < fmt.Sprint(memberOf[j]["path"])
+	})
+
+	h.writeObjects(memberOf)
+}
+
+func (h *realmHandler) groupMembership(userId, groupId string) {
+	if h.realm.get("users", userId) == nil || h.realm.get("", groupId) == nil {
+		writeError(h.w, http.StatusNotFound, "Could not find user or group")
+		return
+	}
+
+	h.realm.groupMembers[groupId] = removeString(h.realm.groupMembers[groupId], userId)
+	if h.r.Method == http.MethodPut {
+		h.realm.groupMembers[groupId] = append(h.realm.groupMembers[groupId], userId)
+	}
+
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *realmHandler) resetPassword(userId string) {
+	if h.realm.get("users", userId) == nil {
+		writeError(h.w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	if _, ok := decode(h.w, h.r); !ok {
+		return
+	}
+
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *realmHandler) federatedIdentity(userId, provider string) {
+	if h.realm.get("users", userId) == nil {
+		writeError(h.w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	collection := "users/" + userId + "/federated-identity"
+	existing := h.realm.find(collection, "identityProvider", provider)
+
+	if h.r.Method == http.MethodDelete {
+		if existing == nil {
+			writeError(h.w, http.StatusNotFound, "Link not found")
+			return
+		}
+
+		h.realm.remove(existing["id"].(string))
+		h.w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if existing != nil {
+		writeError(h.w, http.StatusConflict, "User is already linked with provider")
+		return
+	}
+
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	representation["identityProvider"] = provider
+	h.realm.add(collection, representation)
+
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *realmHandler) flows() {
+	flows := []object{}
+	for _, flow := range h.realm.collections["authentication/flows"] {
+		if flow["topLevel"] == true {
+			flows = append(flows, flow)
+		}
+	}
+
+	h.writeObjects(flows)
+}
+
+func (h *realmHandler) executionsCollection(flowId string) string {
+	return "authentication/flows/" + flowId + "/executions"
+}
+
+// Returns the executions of the flow and its sub flows, in the flattened form returned by Keycloak
+func (h *realmHandler) executionInfos(flowId string, level int) []object {
+	infos := []object{}
+	for index, execution := range h.realm.collections[h.executionsCollection(flowId)] {
+		info := object{
+			"id":                 execution["id"],
+			"requirement":        execution["requirement"],
+			"providerId":         execution["authenticator"],
+			"displayName":        execution["authenticator"],
+			"authenticationFlow": execution["authenticationFlow"],
+			"level":              level,
+			"index":              index,
+			"configurable":       false,
+		}
+
+		if config, ok := execution["authenticationConfig"]; ok {
+			info["authenticationConfig"] = config
+		}
+
+		infos = append(infos, info)
+
+		if execution["authenticationFlow"] == true {
+			subFlow := h.realm.get("authentication/flows", fmt.Sprint(execution["flowId"]))
+			if subFlow == nil {
+				continue
+			}
+
+			info["flowId"] = subFlow["id"]
+			info["displayName"] = subFlow["alias"]
+			info["description"] = subFlow["description"]
+			info["providerId"] = subFlow["providerId"]
+
+			infos = append(infos, h.executionInfos(subFlow["id"].(string), level+1)...)
+		}
+	}
+
+	return infos
+}
+
+func (h *realmHandler) flowByAlias(alias string) object {
+	flow := h.realm.find("authentication/flows", "alias", alias)
+	if flow == nil {
+		writeError(h.w, http.StatusNotFound, "Flow not found")
+	}
+
+	return flow
+}
+
+func (h *realmHandler) flowExecutions(alias string) {
+	flow := h.flowByAlias(alias)
+	if flow == nil {
+		return
+	}
+
+	if h.r.Method == http.MethodGet {
+		h.writeObjects(h.executionInfos(flow["id"].(string), 0))
+		return
+	}
+
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	execution := h.realm.get("", fmt.Sprint(representation["id"]))
+	if execution == nil {
+		writeError(h.w, http.StatusNotFound, "Illegal execution")
+		return
+	}
+
+	if requirement, ok := representation["requirement"]; ok {
+		execution["requirement"] = requirement
+	}
+
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *realmHandler) createExecution(alias, executionType string) {
+	flow := h.flowByAlias(alias)
+	if flow == nil {
+		return
+	}
+
+	representation, ok := decode(h.w, h.r)
+	if !ok {
+		return
+	}
+
+	execution := object{
+		"requirement":        "DISABLED",
+		"authenticationFlow": false,
+		"parentFlow":         flow["id"],
+		"priority":           len(h.realm.collections[h.executionsCollection(flow["id"].(string))]),
+	}
+
+	switch executionType {
+	case "execution":
+		execution["authenticator"] = representation["provider"]
+	case "flow":
+		subFlow, conflict := h.realm.add("authentication/flows", object{
+			"alias":       representation["alias"],
+			"description": representation["description"],
+			"providerId":  representation["type"],
+			"topLevel":    false,
+			"builtIn":     false,
+		})
+		if conflict != "" {
+			writeError(h.w, http.StatusConflict, "New flow alias name already exists")
+			return
+		}
+
+		execution["authenticationFlow"] = true
+		execution["flowId"] = subFlow["id"]
+		execution["authenticator"] = representation["provider"]
+	default:
+		writeError(h.w, http.StatusNotFound, "")
+		return
+	}
+
+	h.realm.add(h.executionsCollection(flow["id"].(string)), execution)
+
+	// the location of a new sub flow is the flow itself, rather than the execution that contains it
+	if executionType == "flow" {
+		created(h.w, h.r, execution["flowId"].(string))
+		return
+	}
+
+	created(h.w, h.r, execution["id"].(string))
+}
+
+func (h *realmHandler) execution(id string) {
+	execution := h.realm.get("", id)
+	if execution == nil || execution["parentFlow"] == nil {
+		writeError(h.w, http.StatusNotFound, "Illegal execution")
+		return
+	}
+
+	if h.r.Method == http.MethodGet {
+		writeJson(h.w, http.StatusOK, execution)
+		return
+	}
+
+	if parent := h.realm.get("", fmt.Sprint(execution["parentFlow"])); parent != nil && parent["builtIn"] == true {
+		writeError(h.w, http.StatusBadRequest, "It is illegal to remove execution from a built in flow")
+		return
+	}
+
+	h.realm.remove(id)
+	if execution["authenticationFlow"] == true {
+		h.realm.remove(fmt.Sprint(execution["flowId"]))
+	}
+
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *realmHandler) moveExecution(id, direction string) {
+	execution := h.realm.get("", id)
+	if execution == nil || execution["parentFlow"] == nil {
+		writeError(h.w, http.StatusNotFound, "Illegal execution")
+		return
+	}
+
+	executions := h.realm.collections[h.executionsCollection(fmt.Sprint(execution["parentFlow"]))]
+	for i := range executions {
+		if executions[i]["id"] != id {
+			continue
+		}
+
+		if direction == "raise" && i > 0 {
+			executions[i-1], executions[i] = executions[i], executions[i-1]
+		} else if direction == "lower" && i < len(executions)-1 {
+			executions[i+1], executions[i] = executions[i], executions[i+1]
+		}
+
+		break
+	}
+
+	for i, e := range executions {
+		e["priority"] = i
+	}
+
+	h.w.WriteHeader(http.StatusNoContent)
+}
+
+func decode(w http.ResponseWriter, r *http.Request) (object, bool) {
+	representation := object{}
+	if err := json.NewDecoder(r.Body).Decode(&representation); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid representation")
+		return nil, false
+	}
+
+	return representation, true
+}
+
+// Responds with the location of the created resource, from which clients take its id
+func created(w http.ResponseWriter, r *http.Request, id string) {
+	location := url.URL{
+		Scheme: "http",
+		Host:   r.Host,
+		Path:   strings.TrimSuffix(r.URL.Path, "/") + "/" + id,
+	}
+
+	w.Header().Set("Location", location.String())
+	w.WriteHeader(http.StatusCreated)
+}
+
+func paginate(objects []object, query url.Values) []object {
+	first, _ := strconv.Atoi(query.Get("first"))
+	if first > len(objects) {
+		first = len(objects)
+	}
+	objects = objects[first:]
+
+	if max, err := strconv.Atoi(query.Get("max")); err == nil && max >= 0 && max < len(objects) {
+		objects = objects[:max]
+	}
+
+	return objects
+}
@@ -0,0 +1,316 @@
+// Package keycloaktest provides an in-memory stand-in for Keycloak, which allows the keycloak and provider packages to
+// be tested without a running server.
+//
+// The server emulates the token endpoint and the parts of the Admin REST API that are used the most: realms, clients,
+// client scopes, roles, groups, users, components, protocol mappers and authentication flows. Representations are
+// stored as they are sent, so anything the API accepts is returned by later requests, but nothing is validated beyond
+// what is needed to return the same errors Keycloak would, such as a 409 for duplicates.
+package keycloaktest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// The realm used for logging in, which exists from the start
+	Realm = "master"
+
+	// Credentials accepted by the token endpoint, for the client credentials and the password grant
+	ClientId     = "terraform"
+	ClientSecret = "884e0f95-0f42-4a63-9b1f-94274655669e"
+	Username     = "keycloak"
+	Password     = "password"
+)
+
+type Server struct {
+	*httptest.Server
+
+	basePath      string
+	serverVersion string
+	tokenLifetime time.Duration
+
+	mutex         sync.Mutex
+	realms        map[string]*realm
+	realmNames    []string
+	accessTokens  map[string]time.Time
+	refreshTokens map[string]bool
+	tokenRequests int
+	requests      []Request
+	failures      []*failure
+}
+
+// A request received by the server, as recorded by Requests
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+}
+
+type failure struct {
+	method     string
+	path       string
+	statusCode int
+	body       string
+}
+
+type Option func(*Server)
+
+// Serves Keycloak under the given base path, which defaults to /auth. Use an empty base path to emulate Keycloak.X.
+func WithBasePath(basePath string) Option {
+	return func(server *Server) {
+		server.basePath = basePath
+	}
+}
+
+// The version reported by /serverinfo, which defaults to 12.0.4
+func WithServerVersion(serverVersion string) Option {
+	return func(server *Server) {
+		server.serverVersion = serverVersion
+	}
+}
+
+// The lifetime of issued access tokens, which defaults to five minutes
+func WithTokenLifetime(tokenLifetime time.Duration) Option {
+	return func(server *Server) {
+		server.tokenLifetime = tokenLifetime
+	}
+}
+
+// Starts a new server, which should be closed when it is no longer used
+func NewServer(options ...Option) *Server {
+	server := &Server{
+		basePath:      "/auth",
+		serverVersion: "12.0.4",
+		tokenLifetime: 5 * time.Minute,
+		realms:        map[string]*realm{},
+		accessTokens:  map[string]time.Time{},
+		refreshTokens: map[string]bool{},
+	}
+
+	for _, option := range options {
+		option(server)
+	}
+
+	server.createRealm(object{"id": Realm, "realm": Realm, "enabled": true})
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+
+	return server
+}
+
+// The base path Keycloak is served under
+func (server *Server) BasePath() string {
+	return server.basePath
+}
+
+// Invalidates all access tokens issued so far, as if they had expired or the server had been restarted
+func (server *Server) ExpireTokens() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.accessTokens = map[string]time.Time{}
+}
+
+// Returns the number of requests to the token endpoint, including refreshes
+func (server *Server) TokenRequests() int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.tokenRequests
+}
+
+// Returns all requests to the Admin API received so far, with paths relative to the base path
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]Request(nil), server.requests...)
+}
+
+// Makes the next request with the given method to the given Admin API path, such as "/admin/realms/test", fail with
+// the given status code and body. An empty method matches any method.
+func (server *Server) FailNext(method, path string, statusCode int, body string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.failures = append(server.failures, &failure{
+		method:     method,
+		path:       path,
+		statusCode: statusCode,
+		body:       body,
+	})
+}
+
+func (server *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if !strings.HasPrefix(r.URL.Path, server.basePath+"/") {
+		writeError(w, http.StatusNotFound, "")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, server.basePath)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case len(segments) == 5 && segments[0] == "realms" && segments[2] == "protocol" && segments[4] == "token" && r.Method == http.MethodPost:
+		server.token(w, r, segments[1])
+	case len(segments) == 4 && segments[0] == "realms" && path == fmt.Sprintf("/realms/%s/.well-known/openid-configuration", segments[1]) && r.Method == http.MethodGet:
+		server.wellKnown(w, segments[1])
+	case segments[0] == "admin":
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		server.requests = append(server.requests, Request{Method: r.Method, Path: path, Query: r.URL.RawQuery, Body: string(body)})
+
+		if !server.authorized(r) {
+			writeError(w, http.StatusUnauthorized, "HTTP 401 Unauthorized")
+			return
+		}
+
+		if server.fail(w, r.Method, path) {
+			return
+		}
+
+		server.admin(w, r, segments[1:])
+	default:
+		writeError(w, http.StatusNotFound, "")
+	}
+}
+
+func (server *Server) token(w http.ResponseWriter, r *http.Request, realmName string) {
+	server.tokenRequests++
+
+	if _, ok := server.realms[realmName]; !ok {
+		writeJson(w, http.StatusNotFound, object{"error": "Realm does not exist"})
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeJson(w, http.StatusBadRequest, object{"error": "invalid_request"})
+		return
+	}
+
+	var authenticated bool
+	switch r.PostForm.Get("grant_type") {
+	case "client_credentials":
+		authenticated = r.PostForm.Get("client_id") == ClientId && r.PostForm.Get("client_secret") == ClientSecret
+	case "password":
+		authenticated = r.PostForm.Get("username") == Username && r.PostForm.Get("password") == Password
+	case "refresh_token":
+		authenticated = server.refreshTokens[r.PostForm.Get("refresh_token")]
+		if !authenticated {
+			writeJson(w, http.StatusBadRequest, object{"error": "invalid_grant", "error_description": "Invalid refresh token"})
+			return
+		}
+
+		delete(server.refreshTokens, r.PostForm.Get("refresh_token"))
+	default:
+		writeJson(w, http.StatusBadRequest, object{"error": "unsupported_grant_type", "error_description": "Unsupported grant_type"})
+		return
+	}
+
+	if !authenticated {
+		writeJson(w, http.StatusUnauthorized, object{"error": "invalid_grant", "error_description": "Invalid user credentials"})
+		return
+	}
+
+	accessToken, refreshToken := newId(), newId()
+	server.accessTokens[accessToken] = time.Now().Add(server.tokenLifetime)
+	server.refreshTokens[refreshToken] = true
+
+	writeJson(w, http.StatusOK, object{
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "bearer",
+		"expires_in":    int(server.tokenLifetime.Seconds()),
+	})
+}
+
+func (server *Server) wellKnown(w http.ResponseWriter, realmName string) {
+	if _, ok := server.realms[realmName]; !ok {
+		writeJson(w, http.StatusNotFound, object{"error": "Realm does not exist"})
+		return
+	}
+
+	issuer := fmt.Sprintf("%s%s/realms/%s", server.URL, server.basePath, realmName)
+
+	writeJson(w, http.StatusOK, object{
+		"issuer":                 issuer,
+		"authorization_endpoint": issuer + "/protocol/openid-connect/auth",
+		"token_endpoint":         issuer + "/protocol/openid-connect/token",
+		"userinfo_endpoint":      issuer + "/protocol/openid-connect/userinfo",
+		"jwks_uri":               issuer + "/protocol/openid-connect/certs",
+	})
+}
+
+func (server *Server) authorized(r *http.Request) bool {
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return false
+	}
+
+	expiresAt, ok := server.accessTokens[parts[1]]
+
+	return ok && time.Now().Before(expiresAt)
+}
+
+func (server *Server) fail(w http.ResponseWriter, method, path string) bool {
+	for i, failure := range server.failures {
+		if (failure.method == "" || failure.method == method) && failure.path == path {
+			server.failures = append(server.failures[:i], server.failures[i+1:]...)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(failure.statusCode)
+			w.Write([]byte(failure.body))
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func newId() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+
+	// version 4 UUIDs, like the ones generated by Keycloak
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	s := hex.EncodeToString(id)
+
+	return fmt.Sprintf("%s-%s-%s-%s-%s", s[0:8], s[8:12], s[12:16], s[16:20], s[20:])
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, errorMessage string) {
+	if errorMessage == "" {
+		w.WriteHeader(statusCode)
+		return
+	}
+
+	writeJson(w, statusCode, object{"errorMessage": errorMessage})
+}
@@ -0,0 +1,180 @@
+package keycloaktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type testClient struct {
+	t           *testing.T
+	server      *Server
+	accessToken string
+}
+
+func newTestClient(t *testing.T) *testClient {
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	response, err := http.PostForm(server.URL+"/auth/realms/master/protocol/openid-connect/token", url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {ClientId},
+		"client_secret": {ClientSecret},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil || token.AccessToken == "" {
+		t.Fatalf("expected an access token, got status %d", response.StatusCode)
+	}
+
+	return &testClient{t: t, server: server, accessToken: token.AccessToken}
+}
+
+// Sends a request to the Admin API, returning the response status, the Location header and the decoded body
+func (c *testClient) send(method, path string, body interface{}) (int, string, interface{}) {
+	c.t.Helper()
+
+	var requestBody []byte
+	if body != nil {
+		requestBody, _ = json.Marshal(body)
+	}
+
+	request, _ := http.NewRequest(method, c.server.URL+"/auth/admin/realms"+path, bytes.NewReader(requestBody))
+	request.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	var result interface{}
+	json.NewDecoder(response.Body).Decode(&result)
+
+	return response.StatusCode, response.Header.Get("Location"), result
+}
+
+func (c *testClient) create(path string, body interface{}) string {
+	c.t.Helper()
+
+	status, location, _ := c.send(http.MethodPost, path, body)
+	if status != http.StatusCreated {
+		c.t.Fatalf("expected POST %s to respond with 201, got %d", path, status)
+	}
+
+	return location[strings.LastIndex(location, "/")+1:]
+}
+
+func TestMatch(t *testing.T) {
+	for _, tc := range []struct {
+		segments string
+		pattern  string
+		params   []string
+		ok       bool
+	}{
+		{"clients/abc/roles", "clients/*/roles", []string{"abc"}, true},
+		{"clients/abc", "clients/*/roles", nil, false},
+		{"default-optional-client-scopes", "default-*-client-scopes", []string{"optional"}, true},
+		{"default--client-scopes", "default-*-client-scopes", nil, false},
+		{"executions/abc/raise-priority", "executions/*/*-priority", []string{"abc", "raise"}, true},
+		{"users/count", "users/*/groups", nil, false},
+	} {
+		params, ok := match(strings.Split(tc.segments, "/"), tc.pattern)
+		if ok != tc.ok || strings.Join(params, ",") != strings.Join(tc.params, ",") {
+			t.Errorf("expected %s to match %s: %t %v, got %t %v", tc.segments, tc.pattern, tc.ok, tc.params, ok, params)
+		}
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	c := newTestClient(t)
+
+	c.accessToken = "invalid"
+	if status, _, _ := c.send(http.MethodGet, "/master", nil); status != http.StatusUnauthorized {
+		t.Errorf("expected a 401 for an invalid token, got %d", status)
+	}
+}
+
+func TestExpireTokens(t *testing.T) {
+	c := newTestClient(t)
+
+	c.server.ExpireTokens()
+	if status, _, _ := c.send(http.MethodGet, "/master", nil); status != http.StatusUnauthorized {
+		t.Errorf("expected a 401 for an expired token, got %d", status)
+	}
+}
+
+func TestFailNext(t *testing.T) {
+	c := newTestClient(t)
+
+	c.server.FailNext(http.MethodGet, "/admin/realms/master", http.StatusServiceUnavailable, `{"error":"unavailable"}`)
+
+	if status, _, _ := c.send(http.MethodGet, "/master", nil); status != http.StatusServiceUnavailable {
+		t.Errorf("expected the injected failure, got %d", status)
+	}
+	if status, _, _ := c.send(http.MethodGet, "/master", nil); status != http.StatusOK {
+		t.Errorf("expected the failure to be injected once, got %d", status)
+	}
+}
+
+func TestConflict(t *testing.T) {
+	c := newTestClient(t)
+
+	c.create("/master/clients", map[string]interface{}{"clientId": "test"})
+
+	status, _, body := c.send(http.MethodPost, "/master/clients", map[string]interface{}{"clientId": "test"})
+	if status != http.StatusConflict || body.(map[string]interface{})["errorMessage"] != "Client test already exists" {
+		t.Errorf("expected a conflict, got %d %v", status, body)
+	}
+}
+
+func TestGroups(t *testing.T) {
+	c := newTestClient(t)
+
+	parentId := c.create("/master/groups", map[string]interface{}{"name": "parent"})
+	childId := c.create("/master/groups/"+parentId+"/children", map[string]interface{}{"name": "child"})
+	c.create("/master/groups", map[string]interface{}{"name": "other"})
+
+	_, _, child := c.send(http.MethodGet, "/master/groups/"+childId, nil)
+	if child.(map[string]interface{})["path"] != "/parent/child" {
+		t.Errorf("expected the path of the child to be /parent/child, got %v", child)
+	}
+
+	_, _, groups := c.send(http.MethodGet, "/master/groups?search=chi", nil)
+	if len(groups.([]interface{})) != 1 || groups.([]interface{})[0].(map[string]interface{})["name"] != "parent" {
+		t.Errorf("expected searching to return the parent of the match, got %v", groups)
+	}
+
+	// deleting a group deletes its children
+	c.send(http.MethodDelete, "/master/groups/"+parentId, nil)
+	if status, _, _ := c.send(http.MethodGet, "/master/groups/"+childId, nil); status != http.StatusNotFound {
+		t.Errorf("expected the child to be deleted with its parent, got %d", status)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	c := newTestClient(t)
+
+	for _, username := range []string{"a", "b", "c", "d", "e"} {
+		c.create("/master/users", map[string]interface{}{"username": username})
+	}
+
+	_, _, users := c.send(http.MethodGet, "/master/users?first=2&max=2", nil)
+	if len(users.([]interface{})) != 2 || users.([]interface{})[0].(map[string]interface{})["username"] != "c" {
+		t.Errorf("expected users c and d, got %v", users)
+	}
+
+	_, _, count := c.send(http.MethodGet, "/master/users/count", nil)
+	if count != float64(5) {
+		t.Errorf("expected 5 users, got %v", count)
+	}
+}
@@ -0,0 +1,299 @@
+package keycloaktest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Representations are kept as they were sent, since the keycloak package can't be imported from here
+type object map[string]interface{}
+
+// Resources are stored in collections named after the path they are created under, relative to the realm, such as
+// "clients" or "clients/<id>/roles". This lets a single implementation serve most of the Admin API.
+type realm struct {
+	representation object
+	collections    map[string][]object
+	entries        map[string]*entry
+
+	defaultClientScopes map[string][]string
+	clientScopes        map[string]map[string][]string
+	groupMembers        map[string][]string
+	defaultGroups       []string
+	composites          map[string][]string
+	localizations       map[string]map[string]string
+	userProfile         object
+	clientPolicies      map[string]interface{}
+}
+
+type entry struct {
+	collection string
+	object     object
+}
+
+// The attribute that has to be unique within a collection, and the message Keycloak responds with if it isn't
+var uniqueAttributes = map[string]struct {
+	attribute string
+	message   string
+}{
+	"clients":       {"clientId", "Client %s already exists"},
+	"client-scopes": {"name", "Client Scope %s already exists"},
+	"roles":         {"name", "Role with name %s already exists"},
+	"groups":        {"name", "Top level group named '%s' already exists."},
+	"children":      {"name", "Sibling group named '%s' already exists."},
+	"users":         {"username", "User exists with same username"},
+	"models":        {"name", "Protocol mapper exists with same name"},
+	"flows":         {"alias", "Flow %s already exists"},
+	"instances":     {"alias", "Identity Provider %s already exists"},
+}
+
+var builtInClientScopes = map[string][]string{
+	"default":  {"profile", "email", "roles", "web-origins"},
+	"optional": {"offline_access", "address", "phone", "microprofile-jwt"},
+}
+
+var builtInFlows = []string{"browser", "direct grant", "registration", "reset credentials", "clients", "first broker login"}
+
+var builtInRoles = []string{"offline_access", "uma_authorization"}
+
+// The policies Keycloak sets up for new realms, which the representation falls back to
+var defaultRealmPolicies = object{
+	"otpPolicyType":                    "totp",
+	"otpPolicyAlgorithm":               "HmacSHA1",
+	"otpPolicyDigits":                  6,
+	"otpPolicyPeriod":                  30,
+	"otpPolicyLookAheadWindow":         1,
+	"otpPolicyInitialCounter":          0,
+	"otpPolicyCodeReusable":            false,
+	"otpSupportedApplications":         []string{"totpAppFreeOTPName", "totpAppGoogleName", "totpAppMicrosoftAuthenticatorName"},
+	"cibaBackchannelTokenDeliveryMode": "poll",
+	"cibaExpiresIn":                    120,
+	"cibaInterval":                     5,
+	"cibaAuthRequestedUserHint":        "login_hint",
+	"oauth2DeviceCodeLifespan":         600,
+	"oauth2DevicePollingInterval":      5,
+	"organizationsEnabled":             false,
+	"adminPermissionsEnabled":          false,
+}
+
+func defaultUserProfile() object {
+	permissions := object{"view": []string{"admin", "user"}, "edit": []string{"admin", "user"}}
+
+	return object{
+		"attributes": []object{
+			{"name": "username", "displayName": "${username}", "permissions": permissions, "validations": object{"length": object{"min": 3, "max": 255}}},
+			{"name": "email", "displayName": "${email}", "permissions": permissions, "validations": object{"email": object{}}},
+		},
+		"groups": []object{
+			{"name": "user-metadata", "displayHeader": "User metadata"},
+		},
+	}
+}
+
+func (server *Server) createRealm(representation object) *realm {
+	name := representation["realm"].(string)
+	if _, ok := representation["id"]; !ok {
+		representation["id"] = name
+	}
+	for key, value := range defaultRealmPolicies {
+		if _, ok := representation[key]; !ok {
+			representation[key] = value
+		}
+	}
+	attributes, ok := representation["attributes"].(map[string]interface{})
+	if !ok {
+		attributes = map[string]interface{}{}
+		representation["attributes"] = attributes
+	}
+	if _, ok := attributes["parRequestUriLifespan"]; !ok {
+		attributes["parRequestUriLifespan"] = "60"
+	}
+
+	r := &realm{
+		representation:      representation,
+		collections:         map[string][]object{},
+		entries:             map[string]*entry{},
+		defaultClientScopes: map[string][]string{},
+		clientScopes:        map[string]map[string][]string{},
+		groupMembers:        map[string][]string{},
+		composites:          map[string][]string{},
+		localizations:       map[string]map[string]string{},
+		userProfile:         defaultUserProfile(),
+		clientPolicies:      map[string]interface{}{"profiles": []object{}, "policies": []object{}},
+	}
+
+	for _, scopeType := range []string{"default", "optional"} {
+		for _, name := range builtInClientScopes[scopeType] {
+			scope, _ := r.add("client-scopes", object{"name": name, "protocol": "openid-connect"})
+			r.defaultClientScopes[scopeType] = append(r.defaultClientScopes[scopeType], scope["id"].(string))
+		}
+	}
+	for _, alias := range builtInFlows {
+		r.add("authentication/flows", object{"alias": alias, "providerId": "basic-flow", "topLevel": true, "builtIn": true})
+	}
+	for _, name := range builtInRoles {
+		r.add("roles", object{"name": name, "composite": false, "clientRole": false, "containerId": representation["id"]})
+	}
+
+	server.realms[name] = r
+	server.realmNames = append(server.realmNames, name)
+
+	return r
+}
+
+func (server *Server) deleteRealm(name string) {
+	delete(server.realms, name)
+
+	for i, realmName := range server.realmNames {
+		if realmName == name {
+			server.realmNames = append(server.realmNames[:i], server.realmNames[i+1:]...)
+			break
+		}
+	}
+}
+
+func collectionKind(collection string) string {
+	return collection[strings.LastIndex(collection, "/")+1:]
+}
+
+// Returns the message of the conflict the object would cause in the collection, or an empty string if there is none
+func (r *realm) conflict(collection string, o object) string {
+	unique, ok := uniqueAttributes[collectionKind(collection)]
+	if !ok {
+		return ""
+	}
+
+	value, _ := o[unique.attribute].(string)
+	for _, existing := range r.collections[collection] {
+		if existing["id"] != o["id"] && strings.EqualFold(fmt.Sprint(existing[unique.attribute]), value) {
+			return fmt.Sprintf(unique.message, value)
+		}
+	}
+
+	return ""
+}
+
+// Adds the object to the collection, assigning it an id unless it already has one
+func (r *realm) add(collection string, o object) (object, string) {
+	if id, _ := o["id"].(string); id == "" {
+		o["id"] = newId()
+	}
+
+	if conflict := r.conflict(collection, o); conflict != "" {
+		return nil, conflict
+	}
+	if _, ok := r.entries[o["id"].(string)]; ok {
+		return nil, fmt.Sprintf("Object with id %s already exists", o["id"])
+	}
+
+	r.collections[collection] = append(r.collections[collection], o)
+	r.entries[o["id"].(string)] = &entry{
+		collection: collection,
+		object:     o,
+	}
+
+	return o, ""
+}
+
+func (r *realm) get(collection, id string) object {
+	e, ok := r.entries[id]
+	if !ok || (collection != "" && e.collection != collection) {
+		return nil
+	}
+
+	return e.object
+}
+
+func (r *realm) find(collection, attribute, value string) object {
+	for _, o := range r.collections[collection] {
+		if o[attribute] == value {
+			return o
+		}
+	}
+
+	return nil
+}
+
+// Replaces the stored representation, keeping its id
+func (r *realm) update(id string, o object) string {
+	e := r.entries[id]
+	o["id"] = id
+
+	if conflict := r.conflict(e.collection, o); conflict != "" {
+		return conflict
+	}
+
+	for i, existing := range r.collections[e.collection] {
+		if existing["id"] == id {
+			r.collections[e.collection][i] = o
+		}
+	}
+	e.object = o
+
+	return ""
+}
+
+// Removes the object, along with everything that was created underneath it
+func (r *realm) remove(id string) {
+	e, ok := r.entries[id]
+	if !ok {
+		return
+	}
+
+	objects := r.collections[e.collection]
+	for i, o := range objects {
+		if o["id"] == id {
+			r.collections[e.collection] = append(objects[:i:i], objects[i+1:]...)
+			break
+		}
+	}
+	delete(r.entries, id)
+
+	prefix := e.collection + "/" + id + "/"
+	if collectionKind(e.collection) == "children" {
+		prefix = "groups/" + id + "/"
+	}
+
+	var nested []string
+	for collection := range r.collections {
+		if strings.HasPrefix(collection, prefix) {
+			nested = append(nested, collection)
+		}
+	}
+	sort.Strings(nested)
+
+	for _, collection := range nested {
+		for _, o := range r.collections[collection] {
+			r.remove(o["id"].(string))
+		}
+		delete(r.collections, collection)
+	}
+
+	delete(r.groupMembers, id)
+	delete(r.composites, id)
+	delete(r.clientScopes, id)
+	for groupId, members := range r.groupMembers {
+		r.groupMembers[groupId] = removeString(members, id)
+	}
+}
+
+func removeString(values []string, value string) []string {
+	result := []string{}
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -30,8 +31,8 @@ func convertFromComponentToLdapMsadLdsUserAccountControlMapper(component *Compon
 	}, nil
 }
 
-func (keycloakClient *KeycloakClient) NewLdapMsadLdsUserAccountControlMapper(ldapMsadLdsUserAccountControlMapper *LdapMsadLdsUserAccountControlMapper) error {
-	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/components", ldapMsadLdsUserAccountControlMapper.RealmId), convertFromLdapMsadLdsUserAccountControlMapperToComponent(ldapMsadLdsUserAccountControlMapper))
+func (keycloakClient *KeycloakClient) NewLdapMsadLdsUserAccountControlMapper(ctx context.Context, ldapMsadLdsUserAccountControlMapper *LdapMsadLdsUserAccountControlMapper) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", ldapMsadLdsUserAccountControlMapper.RealmId), convertFromLdapMsadLdsUserAccountControlMapperToComponent(ldapMsadLdsUserAccountControlMapper))
 	if err != nil {
 		return err
 	}
@@ -41,10 +42,10 @@ func (keycloakClient *KeycloakClient) NewLdapMsadLdsUserAccountControlMapper(lda
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetLdapMsadLdsUserAccountControlMapper(realmId, id string) (*LdapMsadLdsUserAccountControlMapper, error) {
+func (keycloakClient *KeycloakClient) GetLdapMsadLdsUserAccountControlMapper(ctx context.Context, realmId, id string) (*LdapMsadLdsUserAccountControlMapper, error) {
 	var component *Component
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -52,10 +53,10 @@ func (keycloakClient *KeycloakClient) GetLdapMsadLdsUserAccountControlMapper(rea
 	return convertFromComponentToLdapMsadLdsUserAccountControlMapper(component, realmId)
 }
 
-func (keycloakClient *KeycloakClient) UpdateLdapMsadLdsUserAccountControlMapper(ldapMsadLdsUserAccountControlMapper *LdapMsadLdsUserAccountControlMapper) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/components/%s", ldapMsadLdsUserAccountControlMapper.RealmId, ldapMsadLdsUserAccountControlMapper.Id), convertFromLdapMsadLdsUserAccountControlMapperToComponent(ldapMsadLdsUserAccountControlMapper))
+func (keycloakClient *KeycloakClient) UpdateLdapMsadLdsUserAccountControlMapper(ctx context.Context, ldapMsadLdsUserAccountControlMapper *LdapMsadLdsUserAccountControlMapper) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", ldapMsadLdsUserAccountControlMapper.RealmId, ldapMsadLdsUserAccountControlMapper.Id), convertFromLdapMsadLdsUserAccountControlMapperToComponent(ldapMsadLdsUserAccountControlMapper))
 }
 
-func (keycloakClient *KeycloakClient) DeleteLdapMsadLdsUserAccountControlMapper(realmId, id string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+func (keycloakClient *KeycloakClient) DeleteLdapMsadLdsUserAccountControlMapper(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
 }
@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 )
@@ -75,8 +76,8 @@ func convertFromComponentToLdapUserAttributeMapper(component *Component, realmId
 	}, nil
 }
 
-func (keycloakClient *KeycloakClient) NewLdapUserAttributeMapper(ldapUserAttributeMapper *LdapUserAttributeMapper) error {
-	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/components", ldapUserAttributeMapper.RealmId), convertFromLdapUserAttributeMapperToComponent(ldapUserAttributeMapper))
+func (keycloakClient *KeycloakClient) NewLdapUserAttributeMapper(ctx context.Context, ldapUserAttributeMapper *LdapUserAttributeMapper) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", ldapUserAttributeMapper.RealmId), convertFromLdapUserAttributeMapperToComponent(ldapUserAttributeMapper))
 	if err != nil {
 		return err
 	}
@@ -86,10 +87,10 @@ func (keycloakClient *KeycloakClient) NewLdapUserAttributeMapper(ldapUserAttribu
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetLdapUserAttributeMapper(realmId, id string) (*LdapUserAttributeMapper, error) {
+func (keycloakClient *KeycloakClient) GetLdapUserAttributeMapper(ctx context.Context, realmId, id string) (*LdapUserAttributeMapper, error) {
 	var component *Component
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -97,10 +98,10 @@ func (keycloakClient *KeycloakClient) GetLdapUserAttributeMapper(realmId, id str
 	return convertFromComponentToLdapUserAttributeMapper(component, realmId)
 }
 
-func (keycloakClient *KeycloakClient) UpdateLdapUserAttributeMapper(ldapUserAttributeMapper *LdapUserAttributeMapper) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/components/%s", ldapUserAttributeMapper.RealmId, ldapUserAttributeMapper.Id), convertFromLdapUserAttributeMapperToComponent(ldapUserAttributeMapper))
+func (keycloakClient *KeycloakClient) UpdateLdapUserAttributeMapper(ctx context.Context, ldapUserAttributeMapper *LdapUserAttributeMapper) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", ldapUserAttributeMapper.RealmId, ldapUserAttributeMapper.Id), convertFromLdapUserAttributeMapperToComponent(ldapUserAttributeMapper))
 }
 
-func (keycloakClient *KeycloakClient) DeleteLdapUserAttributeMapper(realmId, id string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+func (keycloakClient *KeycloakClient) DeleteLdapUserAttributeMapper(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
 }
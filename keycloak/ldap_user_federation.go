@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -488,21 +489,21 @@ func convertFromComponentToLdapUserFederation(component *Component) (*LdapUserFe
 	return ldap, nil
 }
 
-func (keycloakClient *KeycloakClient) ValidateLdapUserFederation(ldap *LdapUserFederation) error {
+func (keycloakClient *KeycloakClient) ValidateLdapUserFederation(ctx context.Context, ldap *LdapUserFederation) error {
 	if (ldap.BindDn == "" && ldap.BindCredential != "") || (ldap.BindDn != "" && ldap.BindCredential == "") {
-		return fmt.Errorf("validation error: authentication requires both BindDN and BindCredential to be set")
+		return newValidationError("BindDn", "authentication requires both BindDN and BindCredential to be set")
 	}
 
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) NewLdapUserFederation(ldapUserFederation *LdapUserFederation) error {
+func (keycloakClient *KeycloakClient) NewLdapUserFederation(ctx context.Context, ldapUserFederation *LdapUserFederation) error {
 	component, err := convertFromLdapUserFederationToComponent(ldapUserFederation)
 	if err != nil {
 		return err
 	}
 
-	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/components", ldapUserFederation.RealmId), component)
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", ldapUserFederation.RealmId), component)
 	if err != nil {
 		return err
 	}
@@ -512,10 +513,10 @@ func (keycloakClient *KeycloakClient) NewLdapUserFederation(ldapUserFederation *
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetLdapUserFederation(realmId, id string) (*LdapUserFederation, error) {
+func (keycloakClient *KeycloakClient) GetLdapUserFederation(ctx context.Context, realmId, id string) (*LdapUserFederation, error) {
 	var component *Component
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -523,11 +524,11 @@ func (keycloakClient *KeycloakClient) GetLdapUserFederation(realmId, id string)
 	return convertFromComponentToLdapUserFederation(component)
 }
 
-func (keycloakClient *KeycloakClient) GetLdapUserFederationMappers(realmId, id string) (*[]interface{}, error) {
+func (keycloakClient *KeycloakClient) GetLdapUserFederationMappers(ctx context.Context, realmId, id string) (*[]interface{}, error) {
 	var components []*Component
 	var ldapUserFederationMappers []interface{}
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components?parent=%s&type=org.keycloak.storage.ldap.mappers.LDAPStorageMapper", realmId, id), &components, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components?parent=%s&type=org.keycloak.storage.ldap.mappers.LDAPStorageMapper", realmId, id), &components, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -581,10 +582,10 @@ func (keycloakClient *KeycloakClient) GetLdapUserFederationMappers(realmId, id s
 	return &ldapUserFederationMappers, nil
 }
 
-func (keycloakClient *KeycloakClient) DeleteLdapUserFederationMappers(realmId, ldapUserFederationId string) error {
+func (keycloakClient *KeycloakClient) DeleteLdapUserFederationMappers(ctx context.Context, realmId, ldapUserFederationId string) error {
 	var components []*Component
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components?parent=%s&type=org.keycloak.storage.ldap.mappers.LDAPStorageMapper", realmId, ldapUserFederationId), &components, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components?parent=%s&type=org.keycloak.storage.ldap.mappers.LDAPStorageMapper", realmId, ldapUserFederationId), &components, nil)
 	if err != nil {
 		return err
 	}
@@ -598,7 +599,7 @@ func (keycloakClient *KeycloakClient) DeleteLdapUserFederationMappers(realmId, l
 			"msad-user-account-control-mapper",
 			"user-attribute-ldap-mapper",
 			"role-ldap-mapper":
-			err := keycloakClient.DeleteComponent(realmId, component.Id)
+			err := keycloakClient.DeleteComponent(ctx, realmId, component.Id)
 			if err != nil {
 				return err
 			}
@@ -608,15 +609,15 @@ func (keycloakClient *KeycloakClient) DeleteLdapUserFederationMappers(realmId, l
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateLdapUserFederation(ldapUserFederation *LdapUserFederation) error {
+func (keycloakClient *KeycloakClient) UpdateLdapUserFederation(ctx context.Context, ldapUserFederation *LdapUserFederation) error {
 	component, err := convertFromLdapUserFederationToComponent(ldapUserFederation)
 	if err != nil {
 		return err
 	}
 
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/components/%s", ldapUserFederation.RealmId, ldapUserFederation.Id), component)
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", ldapUserFederation.RealmId, ldapUserFederation.Id), component)
 }
 
-func (keycloakClient *KeycloakClient) DeleteLdapUserFederation(realmId, id string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+func (keycloakClient *KeycloakClient) DeleteLdapUserFederation(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
 }
@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -19,8 +20,8 @@ type OpenidClientAuthorizationResource struct {
 	Attributes         map[string][]string              `json:"attributes"`
 }
 
-func (keycloakClient *KeycloakClient) NewOpenidClientAuthorizationResource(resource *OpenidClientAuthorizationResource) error {
-	body, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource", resource.RealmId, resource.ResourceServerId), resource)
+func (keycloakClient *KeycloakClient) NewOpenidClientAuthorizationResource(ctx context.Context, resource *OpenidClientAuthorizationResource) error {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource", resource.RealmId, resource.ResourceServerId), resource)
 	if err != nil {
 		return err
 	}
@@ -31,22 +32,22 @@ func (keycloakClient *KeycloakClient) NewOpenidClientAuthorizationResource(resou
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationResource(realm, resourceServerId, resourceId string) (*OpenidClientAuthorizationResource, error) {
+func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationResource(ctx context.Context, realm, resourceServerId, resourceId string) (*OpenidClientAuthorizationResource, error) {
 	resource := OpenidClientAuthorizationResource{
 		RealmId:          realm,
 		ResourceServerId: resourceServerId,
 	}
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource/%s", realm, resourceServerId, resourceId), &resource, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource/%s", realm, resourceServerId, resourceId), &resource, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &resource, nil
 }
 
-func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationResourceByName(realmId, resourceServerId, name string) (*OpenidClientAuthorizationResource, error) {
+func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationResourceByName(ctx context.Context, realmId, resourceServerId, name string) (*OpenidClientAuthorizationResource, error) {
 	resources := []OpenidClientAuthorizationResource{}
 	params := map[string]string{"name": name}
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource", realmId, resourceServerId), &resources, params)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource", realmId, resourceServerId), &resources, params)
 	if err != nil {
 		return nil, err
 	}
@@ -57,14 +58,14 @@ func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationResourceByName
 	return &resource, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateOpenidClientAuthorizationResource(resource *OpenidClientAuthorizationResource) error {
-	err := keycloakClient.put(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource/%s", resource.RealmId, resource.ResourceServerId, resource.Id), resource)
+func (keycloakClient *KeycloakClient) UpdateOpenidClientAuthorizationResource(ctx context.Context, resource *OpenidClientAuthorizationResource) error {
+	err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource/%s", resource.RealmId, resource.ResourceServerId, resource.Id), resource)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) DeleteOpenidClientAuthorizationResource(realmId, clientId, resourceId string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource/%s", realmId, clientId, resourceId), nil)
+func (keycloakClient *KeycloakClient) DeleteOpenidClientAuthorizationResource(ctx context.Context, realmId, clientId, resourceId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource/%s", realmId, clientId, resourceId), nil)
 }
@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -14,8 +15,8 @@ type OpenidClientAuthorizationScope struct {
 	IconUri          string `json:"iconUri"`
 }
 
-func (keycloakClient *KeycloakClient) NewOpenidClientAuthorizationScope(scope *OpenidClientAuthorizationScope) error {
-	body, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope", scope.RealmId, scope.ResourceServerId), scope)
+func (keycloakClient *KeycloakClient) NewOpenidClientAuthorizationScope(ctx context.Context, scope *OpenidClientAuthorizationScope) error {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope", scope.RealmId, scope.ResourceServerId), scope)
 	if err != nil {
 		return err
 	}
@@ -26,26 +27,26 @@ func (keycloakClient *KeycloakClient) NewOpenidClientAuthorizationScope(scope *O
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationScope(realm, resourceServerId, scopeId string) (*OpenidClientAuthorizationScope, error) {
+func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationScope(ctx context.Context, realm, resourceServerId, scopeId string) (*OpenidClientAuthorizationScope, error) {
 	scope := OpenidClientAuthorizationScope{
 		RealmId:          realm,
 		ResourceServerId: resourceServerId,
 	}
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope/%s", realm, resourceServerId, scopeId), &scope, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope/%s", realm, resourceServerId, scopeId), &scope, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &scope, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateOpenidClientAuthorizationScope(scope *OpenidClientAuthorizationScope) error {
-	err := keycloakClient.put(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope/%s", scope.RealmId, scope.ResourceServerId, scope.Id), scope)
+func (keycloakClient *KeycloakClient) UpdateOpenidClientAuthorizationScope(ctx context.Context, scope *OpenidClientAuthorizationScope) error {
+	err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope/%s", scope.RealmId, scope.ResourceServerId, scope.Id), scope)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) DeleteOpenidClientAuthorizationScope(realmId, resourceServerId, scopeId string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope/%s", realmId, resourceServerId, scopeId), nil)
+func (keycloakClient *KeycloakClient) DeleteOpenidClientAuthorizationScope(ctx context.Context, realmId, resourceServerId, scopeId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope/%s", realmId, resourceServerId, scopeId), nil)
 }
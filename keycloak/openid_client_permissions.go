@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -16,20 +17,20 @@ type OpenidClientPermissions struct {
 	ScopePermissions map[string]interface{} `json:"scopePermissions"`
 }
 
-func (keycloakClient *KeycloakClient) EnableOpenidClientPermissions(realmId, clientId string) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), OpenidClientPermissionsInput{Enabled: true})
+func (keycloakClient *KeycloakClient) EnableOpenidClientPermissions(ctx context.Context, realmId, clientId string) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), OpenidClientPermissionsInput{Enabled: true})
 }
 
-func (keycloakClient *KeycloakClient) DisableOpenidClientPermissions(realmId, clientId string) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), OpenidClientPermissionsInput{Enabled: false})
+func (keycloakClient *KeycloakClient) DisableOpenidClientPermissions(ctx context.Context, realmId, clientId string) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), OpenidClientPermissionsInput{Enabled: false})
 }
 
-func (keycloakClient *KeycloakClient) GetOpenidClientPermissions(realmId, clientId string) (*OpenidClientPermissions, error) {
+func (keycloakClient *KeycloakClient) GetOpenidClientPermissions(ctx context.Context, realmId, clientId string) (*OpenidClientPermissions, error) {
 	var openidClientPermissions OpenidClientPermissions
 	openidClientPermissions.RealmId = realmId
 	openidClientPermissions.ClientId = clientId
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), &openidClientPermissions, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), &openidClientPermissions, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -20,10 +21,10 @@ type OpenidClientScope struct {
 
 type OpenidClientScopeFilterFunc func(*OpenidClientScope) bool
 
-func (keycloakClient *KeycloakClient) NewOpenidClientScope(clientScope *OpenidClientScope) error {
+func (keycloakClient *KeycloakClient) NewOpenidClientScope(ctx context.Context, clientScope *OpenidClientScope) error {
 	clientScope.Protocol = "openid-connect"
 
-	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/client-scopes", clientScope.RealmId), clientScope)
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/client-scopes", clientScope.RealmId), clientScope)
 	if err != nil {
 		return err
 	}
@@ -33,10 +34,10 @@ func (keycloakClient *KeycloakClient) NewOpenidClientScope(clientScope *OpenidCl
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetOpenidClientScope(realmId, id string) (*OpenidClientScope, error) {
+func (keycloakClient *KeycloakClient) GetOpenidClientScope(ctx context.Context, realmId, id string) (*OpenidClientScope, error) {
 	var clientScope OpenidClientScope
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/client-scopes/%s", realmId, id), &clientScope, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-scopes/%s", realmId, id), &clientScope, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,10 +47,10 @@ func (keycloakClient *KeycloakClient) GetOpenidClientScope(realmId, id string) (
 	return &clientScope, nil
 }
 
-func (keycloakClient *KeycloakClient) GetOpenidDefaultClientScopes(realmId, clientId string) (*[]OpenidClientScope, error) {
+func (keycloakClient *KeycloakClient) GetOpenidDefaultClientScopes(ctx context.Context, realmId, clientId string) (*[]OpenidClientScope, error) {
 	var clientScopes []OpenidClientScope
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/default-client-scopes", realmId, clientId), &clientScopes, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/default-client-scopes", realmId, clientId), &clientScopes, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -61,10 +62,10 @@ func (keycloakClient *KeycloakClient) GetOpenidDefaultClientScopes(realmId, clie
 	return &clientScopes, nil
 }
 
-func (keycloakClient *KeycloakClient) GetOpenidOptionalClientScopes(realmId, clientId string) (*[]OpenidClientScope, error) {
+func (keycloakClient *KeycloakClient) GetOpenidOptionalClientScopes(ctx context.Context, realmId, clientId string) (*[]OpenidClientScope, error) {
 	var clientScopes []OpenidClientScope
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/optional-client-scopes", realmId, clientId), &clientScopes, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/optional-client-scopes", realmId, clientId), &clientScopes, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -76,21 +77,21 @@ func (keycloakClient *KeycloakClient) GetOpenidOptionalClientScopes(realmId, cli
 	return &clientScopes, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateOpenidClientScope(clientScope *OpenidClientScope) error {
+func (keycloakClient *KeycloakClient) UpdateOpenidClientScope(ctx context.Context, clientScope *OpenidClientScope) error {
 	clientScope.Protocol = "openid-connect"
 
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/client-scopes/%s", clientScope.RealmId, clientScope.Id), clientScope)
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/client-scopes/%s", clientScope.RealmId, clientScope.Id), clientScope)
 }
 
-func (keycloakClient *KeycloakClient) DeleteOpenidClientScope(realmId, id string) error {
-	return keycloakClient.delete(fmt.Sprintf("/realms/%s/client-scopes/%s", realmId, id), nil)
+func (keycloakClient *KeycloakClient) DeleteOpenidClientScope(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/client-scopes/%s", realmId, id), nil)
 }
 
-func (keycloakClient *KeycloakClient) ListOpenidClientScopesWithFilter(realmId string, filter OpenidClientScopeFilterFunc) ([]*OpenidClientScope, error) {
+func (keycloakClient *KeycloakClient) ListOpenidClientScopesWithFilter(ctx context.Context, realmId string, filter OpenidClientScopeFilterFunc) ([]*OpenidClientScope, error) {
 	var clientScopes []OpenidClientScope
 	var openidClientScopes []*OpenidClientScope
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/client-scopes", realmId), &clientScopes, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-scopes", realmId), &clientScopes, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -107,8 +108,8 @@ func (keycloakClient *KeycloakClient) ListOpenidClientScopesWithFilter(realmId s
 	return openidClientScopes, nil
 }
 
-func (keycloakClient *KeycloakClient) GetOpenIdClientScopeByName(realmId, scopeName string) (*OpenidClientScope, error) {
-	scope, err := keycloakClient.ListOpenidClientScopesWithFilter(realmId, includeOpenidClientScopesMatchingNames([]string{scopeName}))
+func (keycloakClient *KeycloakClient) GetOpenIdClientScopeByName(ctx context.Context, realmId, scopeName string) (*OpenidClientScope, error) {
+	scope, err := keycloakClient.ListOpenidClientScopesWithFilter(ctx, realmId, includeOpenidClientScopesMatchingNames([]string{scopeName}))
 	if err != nil {
 		return nil, err
 	}
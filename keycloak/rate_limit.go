@@ -0,0 +1,126 @@
+package keycloak
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimit bounds the load that a single terraform run can put on the Keycloak Admin API.
+// A zero value for either field means that dimension is not limited.
+type RateLimit struct {
+	RequestsPerSecond     float64
+	MaxConcurrentRequests int
+}
+
+// rateLimitedTransport wraps the client's transport, so every request sent to Keycloak - including logins, refreshes
+// and retries - waits for its turn. A request occupies one of the concurrent slots until its response body is closed.
+type rateLimitedTransport struct {
+	wrapped  http.RoundTripper
+	interval time.Duration
+	slots    chan struct{}
+
+	mutex         sync.Mutex
+	nextRequestAt time.Time
+}
+
+func newRateLimitedTransport(wrapped http.RoundTripper, rateLimit RateLimit) http.RoundTripper {
+	if rateLimit.RequestsPerSecond <= 0 && rateLimit.MaxConcurrentRequests <= 0 {
+		return wrapped
+	}
+
+	transport := &rateLimitedTransport{
+		wrapped: wrapped,
+	}
+
+	if rateLimit.RequestsPerSecond > 0 {
+		transport.interval = time.Duration(float64(time.Second) / rateLimit.RequestsPerSecond)
+	}
+
+	if rateLimit.MaxConcurrentRequests > 0 {
+		transport.slots = make(chan struct{}, rateLimit.MaxConcurrentRequests)
+	}
+
+	return transport
+}
+
+// Requests are spaced evenly, so a burst of requests is spread over time instead of all being sent at once.
+func (transport *rateLimitedTransport) wait(ctx context.Context) error {
+	if transport.interval <= 0 {
+		return nil
+	}
+
+	transport.mutex.Lock()
+
+	now := time.Now()
+	if transport.nextRequestAt.Before(now) {
+		transport.nextRequestAt = now
+	}
+
+	wait := transport.nextRequestAt.Sub(now)
+	transport.nextRequestAt = transport.nextRequestAt.Add(transport.interval)
+
+	transport.mutex.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (transport *rateLimitedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	release := func() {}
+
+	ctx := request.Context()
+
+	if transport.slots != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case transport.slots <- struct{}{}:
+		}
+
+		var once sync.Once
+		release = func() {
+			once.Do(func() {
+				<-transport.slots
+			})
+		}
+	}
+
+	if err := transport.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	response, err := transport.wrapped.RoundTrip(request)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	response.Body = &releasingReadCloser{
+		ReadCloser: response.Body,
+		release:    release,
+	}
+
+	return response, nil
+}
+
+type releasingReadCloser struct {
+	io.ReadCloser
+	release func()
+}
+
+func (body *releasingReadCloser) Close() error {
+	defer body.release()
+
+	return body.ReadCloser.Close()
+}
@@ -0,0 +1,146 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRateLimitTestClient(t *testing.T, rateLimit RateLimit, handler http.HandlerFunc) *KeycloakClient {
+	server := newTestKeycloakServer(t, handler)
+
+	keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{}, "", nil, RetryPolicy{}, rateLimit, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("error creating keycloak client: %s", err)
+	}
+
+	return keycloakClient
+}
+
+func sendConcurrently(t *testing.T, keycloakClient *KeycloakClient, requests int) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := keycloakClient.getRaw(testCtx, "/realms/test", nil); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestRateLimitMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	keycloakClient := newRateLimitTestClient(t, RateLimit{MaxConcurrentRequests: 3}, func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+
+		w.Write([]byte(`{}`))
+	})
+
+	sendConcurrently(t, keycloakClient, 30)
+
+	if max := atomic.LoadInt32(&maxInFlight); max != 3 {
+		t.Fatalf("expected at most 3 requests in flight, observed %d", max)
+	}
+}
+
+func TestRateLimitRequestsPerSecond(t *testing.T) {
+	var requests int32
+
+	keycloakClient := newRateLimitTestClient(t, RateLimit{RequestsPerSecond: 100}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{}`))
+	})
+
+	start := time.Now()
+
+	sendConcurrently(t, keycloakClient, 20)
+
+	// the first request is sent immediately, every following request waits 10ms for its turn
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("expected 20 requests at 100 requests per second to take at least 190ms, took %s", elapsed)
+	}
+	if sent := atomic.LoadInt32(&requests); sent != 20 {
+		t.Fatalf("expected 20 requests, got %d", sent)
+	}
+}
+
+func TestRateLimitQueuedRequestIsCancelled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	keycloakClient := newRateLimitTestClient(t, RateLimit{MaxConcurrentRequests: 1}, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{}`))
+	})
+
+	// occupies the only slot until the test ends
+	go keycloakClient.getRaw(testCtx, "/realms/test", nil)
+
+	ctx, cancel := context.WithTimeout(testCtx, 100*time.Millisecond)
+	defer cancel()
+
+	_, err := keycloakClient.getRaw(ctx, "/realms/test", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the queued request to be cancelled, got %v", err)
+	}
+}
+
+func TestRateLimitRefreshFallsBackToLogin(t *testing.T) {
+	var tokenRequests int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/realms/master/protocol/openid-connect/token", func(w http.ResponseWriter, r *http.Request) {
+		// the second token request is the refresh, which is rejected so the client has to log in again
+		if atomic.AddInt32(&tokenRequests, 1) == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "invalid_grant"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "access", "refresh_token": "refresh", "token_type": "bearer"}`))
+	})
+	mux.HandleFunc("/auth/admin/serverinfo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"systemInfo": {"version": "12.0.4"}}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{MaxConcurrentRequests: 1}, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("error creating keycloak client: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, time.Second)
+	defer cancel()
+
+	if err := keycloakClient.refresh(ctx); err != nil {
+		t.Fatalf("expected the refresh to fall back to logging in, got %v", err)
+	}
+	if requests := atomic.LoadInt32(&tokenRequests); requests != 3 {
+		t.Fatalf("expected 3 token requests, got %d", requests)
+	}
+}
@@ -1,8 +1,8 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
-	"strings"
 )
 
 type Key struct {
@@ -28,6 +28,9 @@ type Realm struct {
 	DisplayNameHtml   string `json:"displayNameHtml"`
 	UserManagedAccess bool   `json:"userManagedAccessAllowed"`
 
+	OrganizationsEnabled    *bool `json:"organizationsEnabled,omitempty"`
+	AdminPermissionsEnabled *bool `json:"adminPermissionsEnabled,omitempty"`
+
 	// Login Config
 	RegistrationAllowed         bool   `json:"registrationAllowed"`
 	RegistrationEmailAsUsername bool   `json:"registrationEmailAsUsername"`
@@ -94,6 +97,26 @@ type Realm struct {
 
 	PasswordPolicy string `json:"passwordPolicy"`
 
+	// OTP, the policies are only sent when they are configured. Zero is a valid look ahead window and initial counter.
+	OtpPolicyType            string   `json:"otpPolicyType,omitempty"`
+	OtpPolicyAlgorithm       string   `json:"otpPolicyAlgorithm,omitempty"`
+	OtpPolicyDigits          int      `json:"otpPolicyDigits,omitempty"`
+	OtpPolicyPeriod          int      `json:"otpPolicyPeriod,omitempty"`
+	OtpPolicyLookAheadWindow *int     `json:"otpPolicyLookAheadWindow,omitempty"`
+	OtpPolicyInitialCounter  *int     `json:"otpPolicyInitialCounter,omitempty"`
+	OtpPolicyCodeReusable    *bool    `json:"otpPolicyCodeReusable,omitempty"`
+	OtpSupportedApplications []string `json:"otpSupportedApplications,omitempty"`
+
+	// CIBA
+	CibaBackchannelTokenDeliveryMode string `json:"cibaBackchannelTokenDeliveryMode,omitempty"`
+	CibaExpiresIn                    int    `json:"cibaExpiresIn,omitempty"`
+	CibaInterval                     *int   `json:"cibaInterval,omitempty"`
+	CibaAuthRequestedUserHint        string `json:"cibaAuthRequestedUserHint,omitempty"`
+
+	// OAuth 2.0 Device Authorization Grant
+	OAuth2DeviceCodeLifespan    int  `json:"oauth2DeviceCodeLifespan,omitempty"`
+	OAuth2DevicePollingInterval *int `json:"oauth2DevicePollingInterval,omitempty"`
+
 	//flow bindings
 	BrowserFlow              string `json:"browserFlow,omitempty"`
 	RegistrationFlow         string `json:"registrationFlow,omitempty"`
@@ -162,24 +185,24 @@ type DefaultClientScopes struct {
 	Optional []DefaultClientScope
 }
 
-func (keycloakClient *KeycloakClient) NewRealm(realm *Realm) error {
-	_, _, err := keycloakClient.post("/realms", realm)
+func (keycloakClient *KeycloakClient) NewRealm(ctx context.Context, realm *Realm) error {
+	_, _, err := keycloakClient.post(ctx, "/realms", realm)
 	if err != nil {
 		return err
 	}
 
-	return keycloakClient.updateDefaultClientScopes(realm)
+	return keycloakClient.updateDefaultClientScopes(ctx, realm)
 }
 
-func (keycloakClient *KeycloakClient) GetRealm(name string) (*Realm, error) {
+func (keycloakClient *KeycloakClient) GetRealm(ctx context.Context, name string) (*Realm, error) {
 	var realm Realm
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s", name), &realm, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s", name), &realm, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	err = keycloakClient.fillInRealDefaultClientScopes(&realm)
+	err = keycloakClient.fillInRealDefaultClientScopes(ctx, &realm)
 	if err != nil {
 		return nil, err
 	}
@@ -187,15 +210,15 @@ func (keycloakClient *KeycloakClient) GetRealm(name string) (*Realm, error) {
 	return &realm, nil
 }
 
-func (keycloakClient *KeycloakClient) getCurrentDefaultClientScopes(realm string) (DefaultClientScopes, error) {
+func (keycloakClient *KeycloakClient) getCurrentDefaultClientScopes(ctx context.Context, realm string) (DefaultClientScopes, error) {
 	var result DefaultClientScopes
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/default-default-client-scopes", realm), &(result.Default), nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/default-default-client-scopes", realm), &(result.Default), nil)
 	if err != nil {
 		return result, err
 	}
 
-	err = keycloakClient.get(fmt.Sprintf("/realms/%s/default-optional-client-scopes", realm), &(result.Optional), nil)
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/default-optional-client-scopes", realm), &(result.Optional), nil)
 	if err != nil {
 		return result, err
 	}
@@ -203,11 +226,11 @@ func (keycloakClient *KeycloakClient) getCurrentDefaultClientScopes(realm string
 	return result, nil
 }
 
-func (keycloakClient *KeycloakClient) getDefaultClientScopesByDefinition(realm *Realm) (DefaultClientScopes, error) {
+func (keycloakClient *KeycloakClient) getDefaultClientScopesByDefinition(ctx context.Context, realm *Realm) (DefaultClientScopes, error) {
 	var result DefaultClientScopes
 	var allDefaultClientScopes []DefaultClientScope
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/client-scopes", realm.Realm), &allDefaultClientScopes, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-scopes", realm.Realm), &allDefaultClientScopes, nil)
 	if err != nil {
 		return result, err
 	}
@@ -223,9 +246,9 @@ func (keycloakClient *KeycloakClient) getDefaultClientScopesByDefinition(realm *
 	return result, nil
 }
 
-func (keycloakClient *KeycloakClient) fillInRealDefaultClientScopes(realm *Realm) error {
+func (keycloakClient *KeycloakClient) fillInRealDefaultClientScopes(ctx context.Context, realm *Realm) error {
 
-	dcs, err := keycloakClient.getCurrentDefaultClientScopes(realm.Realm)
+	dcs, err := keycloakClient.getCurrentDefaultClientScopes(ctx, realm.Realm)
 	if err != nil {
 		return err
 	}
@@ -241,16 +264,16 @@ func (keycloakClient *KeycloakClient) fillInRealDefaultClientScopes(realm *Realm
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetRealms() ([]*Realm, error) {
+func (keycloakClient *KeycloakClient) GetRealms(ctx context.Context) ([]*Realm, error) {
 	var realms []*Realm
 
-	err := keycloakClient.get("/realms", &realms, nil)
+	err := keycloakClient.get(ctx, "/realms", &realms, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, realm := range realms {
-		err = keycloakClient.fillInRealDefaultClientScopes(realm)
+		err = keycloakClient.fillInRealDefaultClientScopes(ctx, realm)
 		if err != nil {
 			return nil, err
 		}
@@ -259,10 +282,10 @@ func (keycloakClient *KeycloakClient) GetRealms() ([]*Realm, error) {
 	return realms, nil
 }
 
-func (keycloakClient *KeycloakClient) GetRealmKeys(name string) (*Keys, error) {
+func (keycloakClient *KeycloakClient) GetRealmKeys(ctx context.Context, name string) (*Keys, error) {
 	var keys Keys
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/keys", name), &keys, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/keys", name), &keys, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -270,39 +293,39 @@ func (keycloakClient *KeycloakClient) GetRealmKeys(name string) (*Keys, error) {
 	return &keys, nil
 }
 
-func (keycloakClient *KeycloakClient) UpdateRealm(realm *Realm) error {
-	err := keycloakClient.put(fmt.Sprintf("/realms/%s", realm.Realm), realm)
+func (keycloakClient *KeycloakClient) UpdateRealm(ctx context.Context, realm *Realm) error {
+	err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s", realm.Realm), realm)
 	if err != nil {
 		return err
 	}
-	keycloakClient.updateDefaultClientScopes(realm)
+	keycloakClient.updateDefaultClientScopes(ctx, realm)
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) updateDefaultClientScopes(realm *Realm) error {
-	dcss, err := keycloakClient.getCurrentDefaultClientScopes(realm.Realm)
+func (keycloakClient *KeycloakClient) updateDefaultClientScopes(ctx context.Context, realm *Realm) error {
+	dcss, err := keycloakClient.getCurrentDefaultClientScopes(ctx, realm.Realm)
 	if err != nil {
 		return err
 	}
-	keycloakClient.removeDefaultClientScopesFromRealm(realm, dcss)
-	dcss, err = keycloakClient.getDefaultClientScopesByDefinition(realm)
+	keycloakClient.removeDefaultClientScopesFromRealm(ctx, realm, dcss)
+	dcss, err = keycloakClient.getDefaultClientScopesByDefinition(ctx, realm)
 	if err != nil {
 		return err
 	}
-	keycloakClient.assignDefaultClientScopesToRealm(realm, dcss)
+	keycloakClient.assignDefaultClientScopesToRealm(ctx, realm, dcss)
 
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) removeDefaultClientScopesFromRealm(realm *Realm, dcss DefaultClientScopes) error {
+func (keycloakClient *KeycloakClient) removeDefaultClientScopesFromRealm(ctx context.Context, realm *Realm, dcss DefaultClientScopes) error {
 	for _, dcs := range dcss.Default {
-		err := keycloakClient.DeleteDefaultDefaultClientScope(realm.Realm, dcs.Id)
+		err := keycloakClient.DeleteDefaultDefaultClientScope(ctx, realm.Realm, dcs.Id)
 		if err != nil {
 			return err
 		}
 	}
 	for _, dcs := range dcss.Optional {
-		err := keycloakClient.DeleteOptionalDefaultClientScope(realm.Realm, dcs.Id)
+		err := keycloakClient.DeleteOptionalDefaultClientScope(ctx, realm.Realm, dcs.Id)
 		if err != nil {
 			return err
 		}
@@ -311,15 +334,15 @@ func (keycloakClient *KeycloakClient) removeDefaultClientScopesFromRealm(realm *
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) assignDefaultClientScopesToRealm(realm *Realm, dcss DefaultClientScopes) error {
+func (keycloakClient *KeycloakClient) assignDefaultClientScopesToRealm(ctx context.Context, realm *Realm, dcss DefaultClientScopes) error {
 	for _, scope := range dcss.Default {
-		err := keycloakClient.AddDefaultDefaultClientScope(realm.Realm, scope)
+		err := keycloakClient.AddDefaultDefaultClientScope(ctx, realm.Realm, scope)
 		if err != nil {
 			return err
 		}
 	}
 	for _, scope := range dcss.Optional {
-		err := keycloakClient.AddOptionalDefaultClientScope(realm.Realm, scope)
+		err := keycloakClient.AddOptionalDefaultClientScope(ctx, realm.Realm, scope)
 		if err != nil {
 			return err
 		}
@@ -327,66 +350,65 @@ func (keycloakClient *KeycloakClient) assignDefaultClientScopesToRealm(realm *Re
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) DeleteRealm(name string) error {
-	err := keycloakClient.delete(fmt.Sprintf("/realms/%s", name), nil)
+func (keycloakClient *KeycloakClient) DeleteRealm(ctx context.Context, name string) error {
+	err := keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s", name), nil)
 	if err != nil {
 		// For whatever reason, this fails sometimes with a 500 during acceptance tests. try again
-		return keycloakClient.delete(fmt.Sprintf("/realms/%s", name), nil)
+		return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s", name), nil)
 	}
 
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) ValidateRealm(realm *Realm) error {
+func (keycloakClient *KeycloakClient) ValidateRealm(ctx context.Context, realm *Realm) error {
 	if realm.DuplicateEmailsAllowed == true && realm.RegistrationEmailAsUsername == true {
-		return fmt.Errorf("validation error: DuplicateEmailsAllowed cannot be true if RegistrationEmailAsUsername is true")
+		return newValidationError("DuplicateEmailsAllowed", "DuplicateEmailsAllowed cannot be true if RegistrationEmailAsUsername is true")
 	}
 
 	if realm.DuplicateEmailsAllowed == true && realm.LoginWithEmailAllowed == true {
-		return fmt.Errorf("validation error: DuplicateEmailsAllowed cannot be true if LoginWithEmailAllowed is true")
+		return newValidationError("DuplicateEmailsAllowed", "DuplicateEmailsAllowed cannot be true if LoginWithEmailAllowed is true")
 	}
 
 	if realm.SslRequired != "none" && realm.SslRequired != "external" && realm.SslRequired != "all" {
-		return fmt.Errorf("validation error: SslRequired should be 'none', 'external' or 'all'")
+		return newValidationError("SslRequired", "SslRequired should be 'none', 'external' or 'all'")
 	}
 
 	// validate if the given theme exists on the server. the keycloak API allows you to use any random string for a theme
-	serverInfo, err := keycloakClient.GetServerInfo()
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
 	if err != nil {
 		return err
 	}
 
 	if realm.LoginTheme != "" && !serverInfo.ThemeIsInstalled("login", realm.LoginTheme) {
-		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server", realm.LoginTheme)
+		return newValidationError("LoginTheme", "theme \"%s\" does not exist on the server", realm.LoginTheme)
 	}
 
 	if realm.AccountTheme != "" && !serverInfo.ThemeIsInstalled("account", realm.AccountTheme) {
-		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server", realm.AccountTheme)
+		return newValidationError("AccountTheme", "theme \"%s\" does not exist on the server", realm.AccountTheme)
 	}
 
 	if realm.AdminTheme != "" && !serverInfo.ThemeIsInstalled("admin", realm.AdminTheme) {
-		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server", realm.AdminTheme)
+		return newValidationError("AdminTheme", "theme \"%s\" does not exist on the server", realm.AdminTheme)
 	}
 
 	if realm.EmailTheme != "" && !serverInfo.ThemeIsInstalled("email", realm.EmailTheme) {
-		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server", realm.EmailTheme)
+		return newValidationError("EmailTheme", "theme \"%s\" does not exist on the server", realm.EmailTheme)
 	}
 
 	if realm.InternationalizationEnabled == true && !contains(realm.SupportLocales, realm.DefaultLocale) {
-		return fmt.Errorf("validation error: DefaultLocale should be in the SupportLocales")
+		return newValidationError("DefaultLocale", "DefaultLocale should be in the SupportLocales")
 	}
 
-	if realm.PasswordPolicy != "" {
-		policies := strings.Split(realm.PasswordPolicy, " and ")
-		for _, policyTypeRepresentation := range policies {
-			policy := strings.Split(policyTypeRepresentation, "(")
-			if !serverInfo.providerInstalled("password-policy", policy[0]) {
-				return fmt.Errorf("validation error: password-policy \"%s\" does not exist on the server, installed providers: %s", policy[0], serverInfo.getInstalledProvidersNames("password-policy"))
-			}
-		}
+	// older versions don't know about these settings at all, so they are only sent when configured
+	if realm.OrganizationsEnabled != nil && !keycloakClient.VersionIsGreaterThanOrEqualTo(Version_25) {
+		return newValidationError("OrganizationsEnabled", "organizations are only supported since Keycloak 25")
 	}
 
-	return nil
+	if realm.AdminPermissionsEnabled != nil && !keycloakClient.VersionIsGreaterThanOrEqualTo(Version_26) {
+		return newValidationError("AdminPermissionsEnabled", "fine-grained admin permissions for the realm are only supported since Keycloak 26")
+	}
+
+	return serverInfo.validatePasswordPolicy(realm.PasswordPolicy)
 }
 
 func contains(s []string, e string) bool {
@@ -0,0 +1,121 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// Client policies apply client policy profiles to the clients matching all of their conditions. Like the profiles,
+// the policies of a realm can only be replaced at once.
+
+type RealmClientPolicyCondition struct {
+	Condition     string                 `json:"condition"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type RealmClientPolicy struct {
+	RealmId     string                        `json:"-"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Enabled     bool                          `json:"enabled"`
+	Conditions  []*RealmClientPolicyCondition `json:"conditions"`
+	Profiles    []string                      `json:"profiles"`
+}
+
+type realmClientPolicies struct {
+	Policies []*RealmClientPolicy `json:"policies"`
+}
+
+func realmClientPoliciesPath(realmId string) string {
+	return fmt.Sprintf("/realms/%s/client-policies/policies", realmId)
+}
+
+// Returns the policies defined in the realm, without the global ones that come with Keycloak
+func (keycloakClient *KeycloakClient) getRealmClientPolicies(ctx context.Context, realmId string) ([]*RealmClientPolicy, error) {
+	var policies realmClientPolicies
+
+	err := keycloakClient.get(ctx, realmClientPoliciesPath(realmId), &policies, map[string]string{"include-global-policies": "false"})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies.Policies, nil
+}
+
+// Reads the policies of the realm, lets modify change them and writes them back, like updateRealmClientPolicyProfiles
+func (keycloakClient *KeycloakClient) updateRealmClientPolicies(ctx context.Context, realmId string, modify func([]*RealmClientPolicy) ([]*RealmClientPolicy, error)) error {
+	keycloakClient.clientPoliciesMutex.Lock()
+	defer keycloakClient.clientPoliciesMutex.Unlock()
+
+	policies, err := keycloakClient.getRealmClientPolicies(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	policies, err = modify(policies)
+	if err != nil {
+		return err
+	}
+
+	if policies == nil {
+		policies = []*RealmClientPolicy{}
+	}
+
+	return keycloakClient.put(ctx, realmClientPoliciesPath(realmId), realmClientPolicies{Policies: policies})
+}
+
+func (keycloakClient *KeycloakClient) NewRealmClientPolicy(ctx context.Context, policy *RealmClientPolicy) error {
+	return keycloakClient.updateRealmClientPolicies(ctx, policy.RealmId, func(policies []*RealmClientPolicy) ([]*RealmClientPolicy, error) {
+		for _, existing := range policies {
+			if existing.Name == policy.Name {
+				return nil, fmt.Errorf("client policy %s already exists in realm %s", policy.Name, policy.RealmId)
+			}
+		}
+
+		return append(policies, policy), nil
+	})
+}
+
+func (keycloakClient *KeycloakClient) GetRealmClientPolicy(ctx context.Context, realmId, name string) (*RealmClientPolicy, error) {
+	policies, err := keycloakClient.getRealmClientPolicies(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies {
+		if policy.Name == name {
+			policy.RealmId = realmId
+
+			return policy, nil
+		}
+	}
+
+	return nil, newNotFoundError(realmClientPoliciesPath(realmId), "client policy %s not found in realm %s", name, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmClientPolicy(ctx context.Context, policy *RealmClientPolicy) error {
+	return keycloakClient.updateRealmClientPolicies(ctx, policy.RealmId, func(policies []*RealmClientPolicy) ([]*RealmClientPolicy, error) {
+		for i, existing := range policies {
+			if existing.Name == policy.Name {
+				policies[i] = policy
+
+				return policies, nil
+			}
+		}
+
+		return nil, newNotFoundError(realmClientPoliciesPath(policy.RealmId), "client policy %s not found in realm %s", policy.Name, policy.RealmId)
+	})
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmClientPolicy(ctx context.Context, realmId, name string) error {
+	return keycloakClient.updateRealmClientPolicies(ctx, realmId, func(policies []*RealmClientPolicy) ([]*RealmClientPolicy, error) {
+		var remaining []*RealmClientPolicy
+		for _, policy := range policies {
+			if policy.Name != name {
+				remaining = append(remaining, policy)
+			}
+		}
+
+		return remaining, nil
+	})
+}
@@ -0,0 +1,121 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// Client policy profiles are sets of executors, which client policies apply to the clients matching their conditions.
+// Keycloak only lets all profiles of a realm be replaced at once, so a single profile is changed by reading all of them
+// and writing them back.
+
+type RealmClientPolicyProfileExecutor struct {
+	Executor      string                 `json:"executor"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type RealmClientPolicyProfile struct {
+	RealmId     string                              `json:"-"`
+	Name        string                              `json:"name"`
+	Description string                              `json:"description,omitempty"`
+	Executors   []*RealmClientPolicyProfileExecutor `json:"executors"`
+}
+
+type realmClientPolicyProfiles struct {
+	Profiles []*RealmClientPolicyProfile `json:"profiles"`
+}
+
+func realmClientPolicyProfilesPath(realmId string) string {
+	return fmt.Sprintf("/realms/%s/client-policies/profiles", realmId)
+}
+
+// Returns the profiles defined in the realm, without the global ones that come with Keycloak
+func (keycloakClient *KeycloakClient) getRealmClientPolicyProfiles(ctx context.Context, realmId string) ([]*RealmClientPolicyProfile, error) {
+	var profiles realmClientPolicyProfiles
+
+	err := keycloakClient.get(ctx, realmClientPolicyProfilesPath(realmId), &profiles, map[string]string{"include-global-profiles": "false"})
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles.Profiles, nil
+}
+
+// Reads the profiles of the realm, lets modify change them and writes them back. Other changes to client policies and
+// profiles wait until this is done, so that they don't overwrite each other.
+func (keycloakClient *KeycloakClient) updateRealmClientPolicyProfiles(ctx context.Context, realmId string, modify func([]*RealmClientPolicyProfile) ([]*RealmClientPolicyProfile, error)) error {
+	keycloakClient.clientPoliciesMutex.Lock()
+	defer keycloakClient.clientPoliciesMutex.Unlock()
+
+	profiles, err := keycloakClient.getRealmClientPolicyProfiles(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	profiles, err = modify(profiles)
+	if err != nil {
+		return err
+	}
+
+	if profiles == nil {
+		profiles = []*RealmClientPolicyProfile{}
+	}
+
+	return keycloakClient.put(ctx, realmClientPolicyProfilesPath(realmId), realmClientPolicyProfiles{Profiles: profiles})
+}
+
+func (keycloakClient *KeycloakClient) NewRealmClientPolicyProfile(ctx context.Context, profile *RealmClientPolicyProfile) error {
+	return keycloakClient.updateRealmClientPolicyProfiles(ctx, profile.RealmId, func(profiles []*RealmClientPolicyProfile) ([]*RealmClientPolicyProfile, error) {
+		for _, existing := range profiles {
+			if existing.Name == profile.Name {
+				return nil, fmt.Errorf("client policy profile %s already exists in realm %s", profile.Name, profile.RealmId)
+			}
+		}
+
+		return append(profiles, profile), nil
+	})
+}
+
+func (keycloakClient *KeycloakClient) GetRealmClientPolicyProfile(ctx context.Context, realmId, name string) (*RealmClientPolicyProfile, error) {
+	profiles, err := keycloakClient.getRealmClientPolicyProfiles(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles {
+		if profile.Name == name {
+			profile.RealmId = realmId
+
+			return profile, nil
+		}
+	}
+
+	return nil, newNotFoundError(realmClientPolicyProfilesPath(realmId), "client policy profile %s not found in realm %s", name, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmClientPolicyProfile(ctx context.Context, profile *RealmClientPolicyProfile) error {
+	return keycloakClient.updateRealmClientPolicyProfiles(ctx, profile.RealmId, func(profiles []*RealmClientPolicyProfile) ([]*RealmClientPolicyProfile, error) {
+		for i, existing := range profiles {
+			if existing.Name == profile.Name {
+				profiles[i] = profile
+
+				return profiles, nil
+			}
+		}
+
+		return nil, newNotFoundError(realmClientPolicyProfilesPath(profile.RealmId), "client policy profile %s not found in realm %s", profile.Name, profile.RealmId)
+	})
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmClientPolicyProfile(ctx context.Context, realmId, name string) error {
+	return keycloakClient.updateRealmClientPolicyProfiles(ctx, realmId, func(profiles []*RealmClientPolicyProfile) ([]*RealmClientPolicyProfile, error) {
+		var remaining []*RealmClientPolicyProfile
+		for _, profile := range profiles {
+			if profile.Name != name {
+				remaining = append(remaining, profile)
+			}
+		}
+
+		return remaining, nil
+	})
+}
@@ -0,0 +1,140 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+)
+
+// Realm keystores are key providers, which are components with the realm as their parent
+
+const realmKeystoreProviderType = "org.keycloak.keys.KeyProvider"
+
+// Settings shared by all key providers
+type RealmKeystore struct {
+	Id      string
+	Name    string
+	RealmId string
+
+	Active   bool
+	Enabled  bool
+	Priority int
+}
+
+func (keystore *RealmKeystore) toComponent(providerId string, config map[string][]string) *Component {
+	config["active"] = []string{strconv.FormatBool(keystore.Active)}
+	config["enabled"] = []string{strconv.FormatBool(keystore.Enabled)}
+	config["priority"] = []string{strconv.Itoa(keystore.Priority)}
+
+	return &Component{
+		Id:           keystore.Id,
+		Name:         keystore.Name,
+		ProviderId:   providerId,
+		ProviderType: realmKeystoreProviderType,
+		ParentId:     keystore.RealmId,
+		Config:       config,
+	}
+}
+
+func convertFromComponentToRealmKeystore(component *Component, realmId string) (RealmKeystore, error) {
+	active, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("active"))
+	if err != nil {
+		return RealmKeystore{}, err
+	}
+
+	enabled, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("enabled"))
+	if err != nil {
+		return RealmKeystore{}, err
+	}
+
+	priority := 0
+	if v := component.getConfig("priority"); v != "" {
+		priority, err = strconv.Atoi(v)
+		if err != nil {
+			return RealmKeystore{}, err
+		}
+	}
+
+	return RealmKeystore{
+		Id:       component.Id,
+		Name:     component.Name,
+		RealmId:  realmId,
+		Active:   active,
+		Enabled:  enabled,
+		Priority: priority,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) newRealmKeystore(ctx context.Context, component *Component) (string, error) {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", component.ParentId), component)
+	if err != nil {
+		return "", err
+	}
+
+	return getIdFromLocationHeader(location), nil
+}
+
+// Returns the key provider with the given id, which has to be created by the given provider
+func (keycloakClient *KeycloakClient) getRealmKeystore(ctx context.Context, realmId, id, providerId string) (*Component, error) {
+	var component Component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if component.ProviderType != realmKeystoreProviderType || component.ProviderId != providerId {
+		return nil, fmt.Errorf("component %s is a %s %s, not a key provider of type %s", id, component.ProviderType, component.ProviderId, providerId)
+	}
+
+	return &component, nil
+}
+
+func (keycloakClient *KeycloakClient) updateRealmKeystore(ctx context.Context, component *Component) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", component.ParentId, component.Id), component)
+}
+
+func (keycloakClient *KeycloakClient) deleteRealmKeystore(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
+
+// Keycloak accepts PKCS#1 and PKCS#8 encoded RSA private keys
+func parseRsaPrivateKeyPem(field, privateKeyPem string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPem))
+	if block == nil {
+		return nil, newValidationError(field, "private key must be PEM encoded")
+	}
+
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, newValidationError(field, "unable to parse private key: %s", err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, newValidationError(field, "private key must be an RSA key")
+	}
+
+	return rsaPrivateKey, nil
+}
+
+func parseCertificatePem(field, certificatePem string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificatePem))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, newValidationError(field, "certificate must be PEM encoded")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, newValidationError(field, "unable to parse certificate: %s", err)
+	}
+
+	return certificate, nil
+}
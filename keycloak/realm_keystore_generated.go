@@ -0,0 +1,69 @@
+package keycloak
+
+import (
+	"context"
+)
+
+// A key provider which generates its own keys, such as rsa-generated or hmac-generated. The settings of the generated
+// keys are kept as they appear in the component config, as they only differ in their names between providers
+type RealmKeystoreGenerated struct {
+	RealmKeystore
+
+	ProviderId string
+	Config     map[string]string
+}
+
+func convertFromRealmKeystoreGeneratedToComponent(keystore *RealmKeystoreGenerated) *Component {
+	config := make(map[string][]string, len(keystore.Config))
+	for key, value := range keystore.Config {
+		config[key] = []string{value}
+	}
+
+	return keystore.toComponent(keystore.ProviderId, config)
+}
+
+func convertFromComponentToRealmKeystoreGenerated(component *Component, realmId string) (*RealmKeystoreGenerated, error) {
+	keystore, err := convertFromComponentToRealmKeystore(component, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	config := make(map[string]string, len(component.Config))
+	for key := range component.Config {
+		config[key] = component.getConfig(key)
+	}
+
+	return &RealmKeystoreGenerated{
+		RealmKeystore: keystore,
+		ProviderId:    component.ProviderId,
+		Config:        config,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreGenerated(ctx context.Context, keystore *RealmKeystoreGenerated) error {
+	id, err := keycloakClient.newRealmKeystore(ctx, convertFromRealmKeystoreGeneratedToComponent(keystore))
+	if err != nil {
+		return err
+	}
+
+	keystore.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreGenerated(ctx context.Context, realmId, id, providerId string) (*RealmKeystoreGenerated, error) {
+	component, err := keycloakClient.getRealmKeystore(ctx, realmId, id, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreGenerated(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreGenerated(ctx context.Context, keystore *RealmKeystoreGenerated) error {
+	return keycloakClient.updateRealmKeystore(ctx, convertFromRealmKeystoreGeneratedToComponent(keystore))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreGenerated(ctx context.Context, realmId, id string) error {
+	return keycloakClient.deleteRealmKeystore(ctx, realmId, id)
+}
@@ -0,0 +1,69 @@
+package keycloak
+
+import (
+	"context"
+)
+
+// Loads a key from a Java keystore file on the Keycloak server
+type RealmKeystoreJavaKeystore struct {
+	RealmKeystore
+
+	Algorithm        string
+	Keystore         string
+	KeystorePassword string
+	KeyAlias         string
+	KeyPassword      string
+}
+
+func convertFromRealmKeystoreJavaKeystoreToComponent(keystore *RealmKeystoreJavaKeystore) *Component {
+	return keystore.toComponent("java-keystore", map[string][]string{
+		"algorithm":        {keystore.Algorithm},
+		"keystore":         {keystore.Keystore},
+		"keystorePassword": {keystore.KeystorePassword},
+		"keyAlias":         {keystore.KeyAlias},
+		"keyPassword":      {keystore.KeyPassword},
+	})
+}
+
+func convertFromComponentToRealmKeystoreJavaKeystore(component *Component, realmId string) (*RealmKeystoreJavaKeystore, error) {
+	keystore, err := convertFromComponentToRealmKeystore(component, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the passwords aren't returned by Keycloak
+	return &RealmKeystoreJavaKeystore{
+		RealmKeystore: keystore,
+		Algorithm:     component.getConfig("algorithm"),
+		Keystore:      component.getConfig("keystore"),
+		KeyAlias:      component.getConfig("keyAlias"),
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreJavaKeystore(ctx context.Context, keystore *RealmKeystoreJavaKeystore) error {
+	id, err := keycloakClient.newRealmKeystore(ctx, convertFromRealmKeystoreJavaKeystoreToComponent(keystore))
+	if err != nil {
+		return err
+	}
+
+	keystore.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreJavaKeystore(ctx context.Context, realmId, id string) (*RealmKeystoreJavaKeystore, error) {
+	component, err := keycloakClient.getRealmKeystore(ctx, realmId, id, "java-keystore")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreJavaKeystore(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreJavaKeystore(ctx context.Context, keystore *RealmKeystoreJavaKeystore) error {
+	return keycloakClient.updateRealmKeystore(ctx, convertFromRealmKeystoreJavaKeystoreToComponent(keystore))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreJavaKeystore(ctx context.Context, realmId, id string) error {
+	return keycloakClient.deleteRealmKeystore(ctx, realmId, id)
+}
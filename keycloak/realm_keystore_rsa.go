@@ -0,0 +1,91 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/rsa"
+)
+
+type RealmKeystoreRsa struct {
+	RealmKeystore
+
+	Algorithm   string
+	PrivateKey  string
+	Certificate string
+}
+
+func convertFromRealmKeystoreRsaToComponent(keystore *RealmKeystoreRsa) *Component {
+	config := map[string][]string{
+		"algorithm":  {keystore.Algorithm},
+		"privateKey": {keystore.PrivateKey},
+	}
+
+	if keystore.Certificate != "" {
+		config["certificate"] = []string{keystore.Certificate}
+	}
+
+	return keystore.toComponent("rsa", config)
+}
+
+func convertFromComponentToRealmKeystoreRsa(component *Component, realmId string) (*RealmKeystoreRsa, error) {
+	keystore, err := convertFromComponentToRealmKeystore(component, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the private key isn't returned by Keycloak
+	return &RealmKeystoreRsa{
+		RealmKeystore: keystore,
+		Algorithm:     component.getConfig("algorithm"),
+		Certificate:   component.getConfig("certificate"),
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) ValidateRealmKeystoreRsa(ctx context.Context, keystore *RealmKeystoreRsa) error {
+	privateKey, err := parseRsaPrivateKeyPem("PrivateKey", keystore.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	if keystore.Certificate == "" {
+		return nil
+	}
+
+	certificate, err := parseCertificatePem("Certificate", keystore.Certificate)
+	if err != nil {
+		return err
+	}
+
+	if publicKey, ok := certificate.PublicKey.(*rsa.PublicKey); !ok || !publicKey.Equal(&privateKey.PublicKey) {
+		return newValidationError("Certificate", "certificate does not match the private key")
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreRsa(ctx context.Context, keystore *RealmKeystoreRsa) error {
+	id, err := keycloakClient.newRealmKeystore(ctx, convertFromRealmKeystoreRsaToComponent(keystore))
+	if err != nil {
+		return err
+	}
+
+	keystore.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreRsa(ctx context.Context, realmId, id string) (*RealmKeystoreRsa, error) {
+	component, err := keycloakClient.getRealmKeystore(ctx, realmId, id, "rsa")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreRsa(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreRsa(ctx context.Context, keystore *RealmKeystoreRsa) error {
+	return keycloakClient.updateRealmKeystore(ctx, convertFromRealmKeystoreRsaToComponent(keystore))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreRsa(ctx context.Context, realmId, id string) error {
+	return keycloakClient.deleteRealmKeystore(ctx, realmId, id)
+}
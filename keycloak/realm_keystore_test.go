@@ -0,0 +1,108 @@
+package keycloak
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func generateTestRsaKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return privateKey
+}
+
+func encodeTestCertificate(t *testing.T, privateKey *rsa.PrivateKey) string {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "tf-acc"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}))
+}
+
+func encodeTestPkcs8Key(t *testing.T, privateKey interface{}) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestValidateRealmKeystoreRsa(t *testing.T) {
+	privateKey := generateTestRsaKey(t)
+	pkcs1 := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+	pkcs8 := encodeTestPkcs8Key(t, privateKey)
+	certificate := encodeTestCertificate(t, privateKey)
+	otherCertificate := encodeTestCertificate(t, generateTestRsaKey(t))
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		privateKey    string
+		certificate   string
+		expectedField string
+	}{
+		{name: "pkcs1 key", privateKey: pkcs1},
+		{name: "pkcs8 key", privateKey: pkcs8},
+		{name: "matching certificate", privateKey: pkcs1, certificate: certificate},
+		{name: "key isn't pem", privateKey: "not a key", expectedField: "PrivateKey"},
+		{name: "key isn't rsa", privateKey: encodeTestPkcs8Key(t, ecdsaKey), expectedField: "PrivateKey"},
+		{name: "certificate isn't pem", privateKey: pkcs1, certificate: "not a certificate", expectedField: "Certificate"},
+		{name: "certificate is a key", privateKey: pkcs1, certificate: pkcs1, expectedField: "Certificate"},
+		{name: "certificate of another key", privateKey: pkcs8, certificate: otherCertificate, expectedField: "Certificate"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := (&KeycloakClient{}).ValidateRealmKeystoreRsa(testCtx, &RealmKeystoreRsa{
+				PrivateKey:  test.privateKey,
+				Certificate: test.certificate,
+			})
+
+			if test.expectedField == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %s", err)
+				}
+				return
+			}
+
+			var validationError *ValidationError
+			if !errors.As(err, &validationError) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+			if validationError.Field != test.expectedField {
+				t.Errorf("expected the error to be about %s, got %s", test.expectedField, validationError.Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// The texts overriding the messages of a realm's themes for a single locale
+type RealmLocalization struct {
+	RealmId string
+	Locale  string
+	Texts   map[string]string
+}
+
+func realmLocalizationPath(realmId, locale string) string {
+	return fmt.Sprintf("/realms/%s/localization/%s", realmId, url.PathEscape(locale))
+}
+
+func (keycloakClient *KeycloakClient) ValidateRealmLocalization(ctx context.Context, localization *RealmLocalization) error {
+	realm, err := keycloakClient.GetRealm(ctx, localization.RealmId)
+	if err != nil {
+		return err
+	}
+
+	// the realm is fetched first, as the version is only known once the client has logged in
+	if !keycloakClient.VersionIsGreaterThanOrEqualTo(Version_12) {
+		return newValidationError("Locale", "realm localization texts require Keycloak 12 or later")
+	}
+
+	if !contains(realm.SupportLocales, localization.Locale) {
+		return newValidationError("Locale", "locale %s is not one of the supported locales of realm %s: %s", localization.Locale, localization.RealmId, strings.Join(realm.SupportLocales, ", "))
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmLocalization(ctx context.Context, realmId, locale string) (*RealmLocalization, error) {
+	texts := map[string]string{}
+
+	err := keycloakClient.get(ctx, realmLocalizationPath(realmId, locale), &texts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RealmLocalization{
+		RealmId: realmId,
+		Locale:  locale,
+		Texts:   texts,
+	}, nil
+}
+
+// Makes the texts of the locale match the given ones, removing any other text
+func (keycloakClient *KeycloakClient) UpdateRealmLocalization(ctx context.Context, localization *RealmLocalization) error {
+	current, err := keycloakClient.GetRealmLocalization(ctx, localization.RealmId, localization.Locale)
+	if err != nil {
+		return err
+	}
+
+	path := realmLocalizationPath(localization.RealmId, localization.Locale)
+
+	for key := range current.Texts {
+		if _, ok := localization.Texts[key]; ok {
+			continue
+		}
+
+		err = keycloakClient.delete(ctx, fmt.Sprintf("%s/%s", path, url.PathEscape(key)), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, text := range localization.Texts {
+		if currentText, ok := current.Texts[key]; ok && currentText == text {
+			continue
+		}
+
+		err = keycloakClient.putText(ctx, fmt.Sprintf("%s/%s", path, url.PathEscape(key)), text)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmLocalization(ctx context.Context, realmId, locale string) error {
+	err := keycloakClient.delete(ctx, realmLocalizationPath(realmId, locale), nil)
+
+	// Keycloak responds with 404 when the locale doesn't have any texts
+	if ErrorIs404(err) {
+		return nil
+	}
+
+	return err
+}
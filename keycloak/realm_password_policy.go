@@ -0,0 +1,130 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// A password policy is sent to Keycloak as a single string of terms joined with " and ", such as
+// "length(12) and upperCase(1) and notUsername". The terms are the ids of password-policy providers, optionally
+// followed by a value in parentheses.
+
+type PasswordPolicyTerm struct {
+	Type  string
+	Value string
+}
+
+func (term PasswordPolicyTerm) String() string {
+	if term.Value == "" {
+		return term.Type
+	}
+
+	return fmt.Sprintf("%s(%s)", term.Type, term.Value)
+}
+
+// Splits a password policy into its terms, in the order they appear in. Values are kept whole as long as their
+// parentheses are balanced, so that a regexPattern can contain groups, or even " and ". Escaped parentheses, such as
+// the \( in a regexPattern, aren't counted.
+func ParsePasswordPolicy(policy string) []PasswordPolicyTerm {
+	var terms []PasswordPolicyTerm
+
+	for _, part := range splitPasswordPolicy(policy) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		term := PasswordPolicyTerm{Type: part}
+		if i := strings.Index(part, "("); i != -1 && strings.HasSuffix(part, ")") {
+			term.Type = part[:i]
+			term.Value = part[i+1 : len(part)-1]
+		}
+
+		// Keycloak writes the value of policies that don't take one as "undefined"
+		if term.Value == "undefined" {
+			term.Value = ""
+		}
+
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+// Splits a password policy on the " and "s that are outside of parentheses
+func splitPasswordPolicy(policy string) []string {
+	var parts []string
+
+	depth := 0
+	start := 0
+	for i := 0; i < len(policy); i++ {
+		switch policy[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ' ':
+			if depth == 0 && strings.HasPrefix(policy[i:], " and ") {
+				parts = append(parts, policy[start:i])
+				start = i + len(" and ")
+				i = start - 1
+			}
+		}
+	}
+
+	return append(parts, policy[start:])
+}
+
+func FormatPasswordPolicy(terms []PasswordPolicyTerm) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		parts = append(parts, term.String())
+	}
+
+	return strings.Join(parts, " and ")
+}
+
+// Returns whether two password policies consist of the same terms, regardless of their order
+func PasswordPoliciesEqual(a, b string) bool {
+	normalize := func(policy string) []string {
+		var parts []string
+		for _, term := range ParsePasswordPolicy(policy) {
+			parts = append(parts, term.String())
+		}
+		sort.Strings(parts)
+
+		return parts
+	}
+
+	return strings.Join(normalize(a), " and ") == strings.Join(normalize(b), " and ")
+}
+
+func (serverInfo *ServerInfo) validatePasswordPolicy(policy string) error {
+	for _, term := range ParsePasswordPolicy(policy) {
+		if !serverInfo.providerInstalled("password-policy", term.Type) {
+			return newValidationError("PasswordPolicy", "password-policy \"%s\" does not exist on the server, installed providers: %s", term.Type, serverInfo.getInstalledProvidersNames("password-policy"))
+		}
+	}
+
+	return nil
+}
+
+// Checks that the policies used by the password policy are installed on the server
+func (keycloakClient *KeycloakClient) ValidatePasswordPolicy(ctx context.Context, policy string) error {
+	if policy == "" {
+		return nil
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	return serverInfo.validatePasswordPolicy(policy)
+}
@@ -0,0 +1,69 @@
+package keycloak
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePasswordPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy   string
+		expected []PasswordPolicyTerm
+	}{
+		"empty": {
+			policy: "",
+		},
+		"values": {
+			policy:   "length(12) and upperCase(1) and notUsername",
+			expected: []PasswordPolicyTerm{{"length", "12"}, {"upperCase", "1"}, {"notUsername", ""}},
+		},
+		"undefined": {
+			policy:   "notUsername(undefined) and notEmail(undefined)",
+			expected: []PasswordPolicyTerm{{"notUsername", ""}, {"notEmail", ""}},
+		},
+		"parentheses in the value": {
+			policy:   "regexPattern(^(a|b)+$) and digits(2)",
+			expected: []PasswordPolicyTerm{{"regexPattern", "^(a|b)+$"}, {"digits", "2"}},
+		},
+		"and in the value": {
+			policy:   "regexPattern(^(?:this and that|(x)+)$) and length(8)",
+			expected: []PasswordPolicyTerm{{"regexPattern", "^(?:this and that|(x)+)$"}, {"length", "8"}},
+		},
+		"escaped parentheses in the value": {
+			policy:   `regexPattern(^\(\d+ and .*$) and digits(2)`,
+			expected: []PasswordPolicyTerm{{"regexPattern", `^\(\d+ and .*$`}, {"digits", "2"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			terms := ParsePasswordPolicy(test.policy)
+			if !reflect.DeepEqual(terms, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, terms)
+			}
+
+			if test.policy != "" && !PasswordPoliciesEqual(FormatPasswordPolicy(terms), test.policy) {
+				t.Errorf("expected %q to be equal to %q", FormatPasswordPolicy(terms), test.policy)
+			}
+		})
+	}
+}
+
+func TestPasswordPoliciesEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"length(12) and upperCase(1)", "upperCase(1) and length(12)", true},
+		{"notUsername(undefined) and length(8)", "length(8) and notUsername", true},
+		{"length(12)", "length(8)", false},
+		{"length(12) and digits(1)", "length(12)", false},
+		{"", "", true},
+	}
+
+	for _, test := range tests {
+		if PasswordPoliciesEqual(test.a, test.b) != test.equal {
+			t.Errorf("expected %q and %q to be equal: %t", test.a, test.b, test.equal)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// The declarative user profile of a realm, which defines the attributes users have, who can see and edit them and how
+// they are validated
+
+type RealmUserProfilePermissions struct {
+	View []string `json:"view"`
+	Edit []string `json:"edit"`
+}
+
+// An attribute is required for the given roles and scopes. Keycloak treats an empty requirement as required for
+// everyone.
+type RealmUserProfileRequired struct {
+	Roles  []string `json:"roles,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+type RealmUserProfileSelector struct {
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+type RealmUserProfileAttribute struct {
+	Name        string                            `json:"name"`
+	DisplayName string                            `json:"displayName,omitempty"`
+	Group       string                            `json:"group,omitempty"`
+	Multivalued bool                              `json:"multivalued,omitempty"`
+	Permissions *RealmUserProfilePermissions      `json:"permissions,omitempty"`
+	Required    *RealmUserProfileRequired         `json:"required,omitempty"`
+	Selector    *RealmUserProfileSelector         `json:"selector,omitempty"`
+	Validations map[string]map[string]interface{} `json:"validations,omitempty"`
+	Annotations map[string]interface{}            `json:"annotations,omitempty"`
+}
+
+type RealmUserProfileGroup struct {
+	Name               string                 `json:"name"`
+	DisplayHeader      string                 `json:"displayHeader,omitempty"`
+	DisplayDescription string                 `json:"displayDescription,omitempty"`
+	Annotations        map[string]interface{} `json:"annotations,omitempty"`
+}
+
+type RealmUserProfile struct {
+	Attributes               []*RealmUserProfileAttribute `json:"attributes"`
+	Groups                   []*RealmUserProfileGroup     `json:"groups,omitempty"`
+	UnmanagedAttributePolicy string                       `json:"unmanagedAttributePolicy,omitempty"`
+}
+
+// The profile Keycloak creates for new realms, which realms are reset to when their profile is no longer managed. The
+// validators against prohibited characters and homographs are left out for versions before Keycloak 24, as servers
+// that don't know a validator reject the whole profile.
+func (keycloakClient *KeycloakClient) DefaultRealmUserProfile() *RealmUserProfile {
+	permissions := func() *RealmUserProfilePermissions {
+		return &RealmUserProfilePermissions{View: []string{"admin", "user"}, Edit: []string{"admin", "user"}}
+	}
+
+	userProfile := &RealmUserProfile{
+		Attributes: []*RealmUserProfileAttribute{
+			{
+				Name:        "username",
+				DisplayName: "${username}",
+				Permissions: permissions(),
+				Validations: map[string]map[string]interface{}{
+					"length": {"min": 3, "max": 255},
+				},
+			},
+			{
+				Name:        "email",
+				DisplayName: "${email}",
+				Permissions: permissions(),
+				Required:    &RealmUserProfileRequired{Roles: []string{"user"}},
+				Validations: map[string]map[string]interface{}{
+					"email":  {},
+					"length": {"max": 255},
+				},
+			},
+			{
+				Name:        "firstName",
+				DisplayName: "${firstName}",
+				Permissions: permissions(),
+				Required:    &RealmUserProfileRequired{Roles: []string{"user"}},
+				Validations: map[string]map[string]interface{}{
+					"length": {"max": 255},
+				},
+			},
+			{
+				Name:        "lastName",
+				DisplayName: "${lastName}",
+				Permissions: permissions(),
+				Required:    &RealmUserProfileRequired{Roles: []string{"user"}},
+				Validations: map[string]map[string]interface{}{
+					"length": {"max": 255},
+				},
+			},
+		},
+		Groups: []*RealmUserProfileGroup{
+			{
+				Name:               "user-metadata",
+				DisplayHeader:      "User metadata",
+				DisplayDescription: "Attributes, which refer to user metadata",
+			},
+		},
+	}
+
+	if keycloakClient.VersionIsGreaterThanOrEqualTo(Version_24) {
+		for _, attribute := range userProfile.Attributes {
+			switch attribute.Name {
+			case "username":
+				attribute.Validations["username-prohibited-characters"] = map[string]interface{}{}
+				attribute.Validations["up-username-not-idn-homograph"] = map[string]interface{}{}
+			case "firstName", "lastName":
+				attribute.Validations["person-name-prohibited-characters"] = map[string]interface{}{}
+			}
+		}
+	}
+
+	return userProfile
+}
+
+func (keycloakClient *KeycloakClient) ValidateRealmUserProfile(ctx context.Context, userProfile *RealmUserProfile) error {
+	groups := map[string]bool{}
+	for _, group := range userProfile.Groups {
+		if groups[group.Name] {
+			return newValidationError("Group", "group %s is defined more than once", group.Name)
+		}
+
+		groups[group.Name] = true
+	}
+
+	attributes := map[string]bool{}
+	for _, attribute := range userProfile.Attributes {
+		if attributes[attribute.Name] {
+			return newValidationError("Attribute", "attribute %s is defined more than once", attribute.Name)
+		}
+
+		attributes[attribute.Name] = true
+
+		if attribute.Group != "" && !groups[attribute.Group] {
+			return newValidationError("Attribute", "attribute %s belongs to group %s, which isn't defined", attribute.Name, attribute.Group)
+		}
+	}
+
+	// Keycloak rejects profiles without the attributes it relies on
+	for _, name := range []string{"username", "email"} {
+		if !attributes[name] {
+			return newValidationError("Attribute", "the user profile has to define the %s attribute", name)
+		}
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmUserProfile(ctx context.Context, realmId string) (*RealmUserProfile, error) {
+	var userProfile RealmUserProfile
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), &userProfile, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userProfile, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmUserProfile(ctx context.Context, realmId string, userProfile *RealmUserProfile) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), userProfile)
+}
@@ -0,0 +1,384 @@
+package keycloak
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	RecordingModeRecord = "record"
+	RecordingModeReplay = "replay"
+)
+
+// Recording captures the HTTP traffic with Keycloak in a cassette, or serves a recorded cassette back instead of
+// contacting Keycloak at all. This lets acceptance tests run offline, and makes it possible to diff the requests sent
+// to different Keycloak versions.
+type Recording struct {
+	// One of "record" or "replay". Nothing is recorded when empty
+	Mode string
+	// The cassette file, which holds one interaction per line
+	Cassette string
+	// Matches values which change on every run, such as the random names generated by acceptance tests. When
+	// replaying, requests are matched with these values ignored, and the values from the recording are replaced with
+	// the ones seen in the requests.
+	RandomValues *regexp.Regexp
+}
+
+// A request and the response it got, as stored in a cassette. Bodies are redacted with the same rules as the DEBUG logs,
+// so that cassettes can be shared without leaking credentials, tokens, client secrets or private keys. When replaying,
+// the redacted values are returned instead of the original ones.
+type interaction struct {
+	Method       string            `json:"method"`
+	Path         string            `json:"path"`
+	RequestBody  string            `json:"requestBody,omitempty"`
+	StatusCode   int               `json:"statusCode"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	ResponseBody string            `json:"responseBody,omitempty"`
+}
+
+// Response headers the client relies on, all others aren't recorded
+var recordedHeaders = []string{"Content-Type", "Location", "Retry-After"}
+
+func isTokenRequest(path string) bool {
+	return strings.HasSuffix(path, "/protocol/openid-connect/token")
+}
+
+func newRecordingTransport(wrapped http.RoundTripper, recording Recording) (http.RoundTripper, error) {
+	switch recording.Mode {
+	case "":
+		return wrapped, nil
+	case RecordingModeRecord:
+		cassette, err := os.Create(recording.Cassette)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cassette: %s", err)
+		}
+
+		if err := cassette.Close(); err != nil {
+			return nil, fmt.Errorf("error creating cassette: %s", err)
+		}
+
+		return &recordingTransport{
+			wrapped:  wrapped,
+			cassette: recording.Cassette,
+		}, nil
+	case RecordingModeReplay:
+		interactions, err := readCassette(recording.Cassette)
+		if err != nil {
+			return nil, err
+		}
+
+		return &replayingTransport{
+			interactions: interactions,
+			used:         make([]bool, len(interactions)),
+			randomValues: recording.RandomValues,
+			replacements: map[string]string{},
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid recording mode %s, must be one of %s or %s", recording.Mode, RecordingModeRecord, RecordingModeReplay)
+	}
+}
+
+func readCassette(path string) ([]*interaction, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette: %s", err)
+	}
+	defer file.Close()
+
+	var interactions []*interaction
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var i interaction
+		if err := json.Unmarshal(scanner.Bytes(), &i); err != nil {
+			return nil, fmt.Errorf("error parsing cassette %s: %s", path, err)
+		}
+
+		interactions = append(interactions, &i)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading cassette: %s", err)
+	}
+
+	return interactions, nil
+}
+
+// Returns the request's body without consuming it
+func readRequestBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+
+		return ioutil.ReadAll(body)
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
+	request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// The request body as it is stored in a cassette
+func recordedRequestBody(request *http.Request) (string, error) {
+	body, err := readRequestBody(request)
+	if err != nil || len(body) == 0 {
+		return "", err
+	}
+
+	if isTokenRequest(request.URL.Path) {
+		formData, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", err
+		}
+
+		return redactFormData(formData), nil
+	}
+
+	return redactJson(body), nil
+}
+
+type recordingTransport struct {
+	wrapped http.RoundTripper
+
+	mutex sync.Mutex
+	// the cassette is opened for every interaction, as nothing tells the transport when the last request was sent
+	cassette string
+}
+
+func (transport *recordingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	requestBody, err := recordedRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := transport.wrapped.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	i := &interaction{
+		Method:      request.Method,
+		Path:        request.URL.RequestURI(),
+		RequestBody: requestBody,
+		StatusCode:  response.StatusCode,
+		Headers:     map[string]string{},
+	}
+
+	if len(responseBody) != 0 {
+		i.ResponseBody = redactJson(responseBody)
+	}
+
+	for _, header := range recordedHeaders {
+		if value := response.Header.Get(header); value != "" {
+			i.Headers[header] = value
+		}
+	}
+
+	if err := transport.write(i); err != nil {
+		log.Printf("[WARN] Error recording %s to %s: %s", request.Method, request.URL.Path, err)
+	}
+
+	return response, nil
+}
+
+func (transport *recordingTransport) write(i *interaction) error {
+	line, err := json.Marshal(i)
+	if err != nil {
+		return err
+	}
+
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+
+	cassette, err := os.OpenFile(transport.cassette, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+
+	if _, err := cassette.Write(append(line, '\n')); err != nil {
+		cassette.Close()
+		return err
+	}
+
+	return cassette.Close()
+}
+
+// Serves every request from the cassette. Interactions are used in the order they were recorded, and each is only used
+// once, so that repeated requests for the same resource can get different responses as the resource changes. Token
+// requests are the exception, as how often the client logs in depends on timing.
+type replayingTransport struct {
+	randomValues *regexp.Regexp
+
+	mutex        sync.Mutex
+	interactions []*interaction
+	used         []bool
+	// recorded random values, mapped to the ones seen during this run
+	replacements map[string]string
+}
+
+// Replaces random values with a placeholder
+func (transport *replayingTransport) normalize(s string) string {
+	if transport.randomValues == nil {
+		return s
+	}
+
+	return transport.randomValues.ReplaceAllString(s, "*")
+}
+
+// Replaces random values from this run with the recorded ones they were mapped to
+func (transport *replayingTransport) toRecorded(s string) string {
+	if transport.randomValues == nil {
+		return s
+	}
+
+	return transport.randomValues.ReplaceAllStringFunc(s, func(value string) string {
+		for recorded, replacement := range transport.replacements {
+			if replacement == value {
+				return recorded
+			}
+		}
+
+		return value
+	})
+}
+
+// Replaces recorded random values with the ones they were mapped to
+func (transport *replayingTransport) fromRecorded(s string) string {
+	if transport.randomValues == nil {
+		return s
+	}
+
+	return transport.randomValues.ReplaceAllStringFunc(s, func(value string) string {
+		if replacement, ok := transport.replacements[value]; ok {
+			return replacement
+		}
+
+		return value
+	})
+}
+
+// Learns which random values of this run correspond to the recorded ones, from requests that only differ in them
+func (transport *replayingTransport) learn(recorded, actual string) {
+	if transport.randomValues == nil {
+		return
+	}
+
+	recordedValues := transport.randomValues.FindAllString(recorded, -1)
+	actualValues := transport.randomValues.FindAllString(actual, -1)
+
+	for i := 0; i < len(recordedValues) && i < len(actualValues); i++ {
+		if _, ok := transport.replacements[recordedValues[i]]; !ok {
+			transport.replacements[recordedValues[i]] = actualValues[i]
+		}
+	}
+}
+
+func (transport *replayingTransport) find(method, path, body string) *interaction {
+	tokenRequest := isTokenRequest(path)
+
+	// requests with already known random values have to match exactly, otherwise the first matching request with
+	// random values ignored is used
+	for _, exact := range []bool{true, false} {
+		for index, i := range transport.interactions {
+			if i.Method != method || (transport.used[index] && !tokenRequest) {
+				continue
+			}
+
+			var matches bool
+			if exact {
+				matches = i.Path == transport.toRecorded(path) && i.RequestBody == transport.toRecorded(body)
+			} else {
+				matches = transport.normalize(i.Path) == transport.normalize(path) && transport.normalize(i.RequestBody) == transport.normalize(body)
+			}
+
+			if !matches {
+				continue
+			}
+
+			if !exact {
+				transport.learn(i.Path+" "+i.RequestBody, path+" "+body)
+			}
+
+			transport.used[index] = true
+
+			return i
+		}
+	}
+
+	return nil
+}
+
+func (transport *replayingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	body, err := recordedRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	path := request.URL.RequestURI()
+
+	transport.mutex.Lock()
+	i := transport.find(request.Method, path, body)
+	var responseBody string
+	if i != nil {
+		responseBody = transport.fromRecorded(i.ResponseBody)
+	}
+	transport.mutex.Unlock()
+
+	if i == nil {
+		return nil, fmt.Errorf("no recorded interaction matches %s %s", request.Method, path)
+	}
+
+	response := &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          ioutil.NopCloser(strings.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       request,
+	}
+
+	for header, value := range i.Headers {
+		response.Header.Set(header, transport.fromRecorded(value))
+	}
+
+	return response, nil
+}
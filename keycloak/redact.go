@@ -0,0 +1,103 @@
+package keycloak
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// Sensitive values are replaced with this before anything is logged, as DEBUG logs tend to end up in CI artifacts
+const redactedValue = "**REDACTED**"
+
+// Form fields of token requests which must not be logged
+var sensitiveFormFields = []string{
+	"password",
+	"client_secret",
+	"client_assertion",
+	"refresh_token",
+}
+
+// JSON keys of token responses and Admin API representations whose values must not be logged, no matter how deeply
+// they are nested. Component configs such as LDAP's bindCredential or the private key of an RSA keystore hold their
+// values in arrays, which are redacted as a whole.
+var sensitiveJsonKeys = map[string]bool{
+	"access_token":     true,
+	"refresh_token":    true,
+	"id_token":         true,
+	"secret":           true,
+	"password":         true,
+	"clientSecret":     true,
+	"bindCredential":   true,
+	"privateKey":       true,
+	"keystorePassword": true,
+	"keyPassword":      true,
+}
+
+// Credential representations, such as a user's password or a client's secret, hold the sensitive value in their
+// "value" field
+var sensitiveCredentialTypes = map[string]bool{
+	"password": true,
+	"secret":   true,
+}
+
+func redactFormData(data url.Values) string {
+	redacted := url.Values{}
+
+	for key, values := range data {
+		redacted[key] = values
+	}
+
+	for _, field := range sensitiveFormFields {
+		if _, ok := redacted[field]; ok {
+			redacted.Set(field, redactedValue)
+		}
+	}
+
+	// keep the redacted value readable, instead of percent encoding it
+	return strings.ReplaceAll(redacted.Encode(), url.QueryEscape(redactedValue), redactedValue)
+}
+
+// Returns the given JSON body with all sensitive values redacted. Bodies that aren't JSON, such as error pages, are
+// returned as is.
+func redactJson(body []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactJsonValue(value))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+func redactJsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if sensitiveJsonKeys[key] {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactJsonValue(nested)
+			}
+		}
+
+		if credentialType, ok := v["type"].(string); ok && sensitiveCredentialTypes[credentialType] {
+			if _, ok := v["value"]; ok {
+				v["value"] = redactedValue
+			}
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactJsonValue(nested)
+		}
+	}
+
+	return value
+}
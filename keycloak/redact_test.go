@@ -0,0 +1,204 @@
+package keycloak
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testSecret = "s3cr3t-value"
+
+func assertRedacted(t *testing.T, name, redacted string, expectedFragments ...string) {
+	t.Helper()
+
+	if strings.Contains(redacted, testSecret) {
+		t.Errorf("%s: expected secret to be redacted, got %s", name, redacted)
+	}
+	if !strings.Contains(redacted, redactedValue) {
+		t.Errorf("%s: expected redacted value in %s", name, redacted)
+	}
+	for _, fragment := range expectedFragments {
+		if !strings.Contains(redacted, fragment) {
+			t.Errorf("%s: expected %s to be kept in %s", name, fragment, redacted)
+		}
+	}
+}
+
+func redactRepresentation(t *testing.T, representation interface{}) string {
+	t.Helper()
+
+	body, err := json.Marshal(representation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return redactJson(body)
+}
+
+func TestRedactFormData(t *testing.T) {
+	assertRedacted(t, "password grant", redactFormData(url.Values{
+		"client_id":  {"admin-cli"},
+		"grant_type": {"password"},
+		"username":   {"keycloak"},
+		"password":   {testSecret},
+	}), "client_id=admin-cli", "username=keycloak", "grant_type=password")
+
+	assertRedacted(t, "client credentials grant", redactFormData(url.Values{
+		"client_id":     {"terraform"},
+		"client_secret": {testSecret},
+		"grant_type":    {"client_credentials"},
+	}), "client_id=terraform")
+
+	assertRedacted(t, "signed JWT", redactFormData(url.Values{
+		"client_id":             {"terraform"},
+		"client_assertion_type": {clientAssertionType},
+		"client_assertion":      {testSecret},
+	}), "client_assertion_type=")
+
+	assertRedacted(t, "refresh", redactFormData(url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {testSecret},
+	}), "grant_type=refresh_token")
+}
+
+func TestRedactFormDataDoesNotModifyRequest(t *testing.T) {
+	data := url.Values{"password": {testSecret}}
+
+	redactFormData(data)
+
+	if data.Get("password") != testSecret {
+		t.Fatal("expected the form data that is sent to be left untouched")
+	}
+}
+
+func TestRedactTokenResponse(t *testing.T) {
+	assertRedacted(t, "token response", redactJson([]byte(`{
+		"access_token": "`+testSecret+`",
+		"refresh_token": "`+testSecret+`",
+		"id_token": "`+testSecret+`",
+		"token_type": "bearer",
+		"expires_in": 300
+	}`)), `"token_type":"bearer"`, `"expires_in":300`)
+}
+
+func TestRedactUserPassword(t *testing.T) {
+	assertRedacted(t, "reset password", redactRepresentation(t, &PasswordCredentials{
+		Type:      "password",
+		Value:     testSecret,
+		Temporary: true,
+	}), `"temporary":true`)
+
+	assertRedacted(t, "user with credentials", redactJson([]byte(`{
+		"username": "bob",
+		"credentials": [{"type": "password", "value": "`+testSecret+`", "temporary": false}]
+	}`)), `"username":"bob"`)
+}
+
+func TestRedactOpenidClientSecret(t *testing.T) {
+	assertRedacted(t, "openid client", redactRepresentation(t, &OpenidClient{
+		ClientId:     "terraform",
+		ClientSecret: testSecret,
+	}), `"clientId":"terraform"`)
+
+	assertRedacted(t, "client secret", redactRepresentation(t, &OpenidClientSecret{
+		Type:  "secret",
+		Value: testSecret,
+	}), `"type":"secret"`)
+}
+
+func TestRedactLdapBindCredential(t *testing.T) {
+	component, err := convertFromLdapUserFederationToComponent(&LdapUserFederation{
+		Name:           "ldap",
+		BindDn:         "cn=admin",
+		BindCredential: testSecret,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertRedacted(t, "ldap user federation", redactRepresentation(t, component), `"bindDn":["cn=admin"]`)
+}
+
+func TestRedactRealmKeystoreSecrets(t *testing.T) {
+	assertRedacted(t, "rsa keystore", redactRepresentation(t, convertFromRealmKeystoreRsaToComponent(&RealmKeystoreRsa{
+		RealmKeystore: RealmKeystore{Name: "rsa"},
+		PrivateKey:    testSecret,
+	})), `"name":"rsa"`)
+
+	assertRedacted(t, "java keystore", redactRepresentation(t, convertFromRealmKeystoreJavaKeystoreToComponent(&RealmKeystoreJavaKeystore{
+		RealmKeystore:    RealmKeystore{Name: "java"},
+		Keystore:         "/opt/keycloak/keystore.jks",
+		KeystorePassword: testSecret,
+		KeyAlias:         "keycloak",
+		KeyPassword:      testSecret,
+	})), `"name":"java"`, `"keystore":["/opt/keycloak/keystore.jks"]`, `"keyAlias":["keycloak"]`)
+}
+
+func TestRedactRealmSmtpPassword(t *testing.T) {
+	assertRedacted(t, "realm", redactRepresentation(t, &Realm{
+		Realm: "test",
+		SmtpServer: SmtpServer{
+			Host:     "smtp.example.com",
+			User:     "keycloak",
+			Password: testSecret,
+		},
+	}), `"realm":"test"`, `"host":"smtp.example.com"`, `"user":"keycloak"`)
+}
+
+func TestRedactIdentityProviderClientSecret(t *testing.T) {
+	assertRedacted(t, "identity provider", redactRepresentation(t, &IdentityProvider{
+		Alias: "oidc",
+		Config: &IdentityProviderConfig{
+			ClientId:     "keycloak",
+			ClientSecret: testSecret,
+		},
+	}), `"alias":"oidc"`, `"clientId":"keycloak"`)
+}
+
+func TestRedactKeepsOtherValues(t *testing.T) {
+	for _, body := range []string{
+		`{"type": "totp", "value": "kept"}`,
+		`{"name": "value", "value": "kept"}`,
+		`[{"id": "1"}, {"id": "2"}]`,
+		`<html>Bad Gateway</html>`,
+		``,
+	} {
+		redacted := redactJson([]byte(body))
+		if strings.Contains(redacted, redactedValue) {
+			t.Errorf("expected nothing to be redacted in %s, got %s", body, redacted)
+		}
+	}
+}
+
+func TestDebugLogsDoNotContainSecrets(t *testing.T) {
+	server := newTestKeycloakServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"type": "secret", "value": "` + testSecret + `"}`))
+	})
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "", "master", "keycloak", testSecret, true, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = keycloakClient.put(testCtx, "/realms/test", &PasswordCredentials{Type: "password", Value: testSecret})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.Contains(logs.String(), "Request body") || !strings.Contains(logs.String(), "Response body") {
+		t.Fatalf("expected request and response bodies to be logged, got %s", logs.String())
+	}
+	if strings.Contains(logs.String(), testSecret) {
+		t.Fatalf("expected secrets to be redacted from the logs, got %s", logs.String())
+	}
+}
@@ -0,0 +1,171 @@
+package keycloak
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how often, and how patiently, failed requests to the Keycloak API are retried.
+// Only idempotent requests are retried, and only for failures that are likely to be transient.
+type RetryPolicy struct {
+	MaxRetries int
+	WaitMin    time.Duration
+	WaitMax    time.Duration
+}
+
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// Connection resets, refused connections and timeouts are what we see from a load balancer while Keycloak nodes are
+// being restarted. Anything else (bad certificates, malformed URLs, etc.) will not go away by trying again.
+func isRetryableError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return false
+}
+
+// Parses the Retry-After header, which can either be a number of seconds or an HTTP date
+func parseRetryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	retryAfter := response.Header.Get("Retry-After")
+	if retryAfter == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// Exponential backoff with jitter: the n-th retry waits somewhere between half and all of WaitMin * 2^n, capped at WaitMax.
+// A Retry-After header sent by the server takes precedence, but is still capped at WaitMax.
+func (retryPolicy RetryPolicy) backoff(attempt int, response *http.Response) time.Duration {
+	if wait, ok := parseRetryAfter(response); ok {
+		if retryPolicy.WaitMax > 0 && wait > retryPolicy.WaitMax {
+			return retryPolicy.WaitMax
+		}
+
+		return wait
+	}
+
+	wait := retryPolicy.WaitMin
+	for i := 0; i < attempt && (retryPolicy.WaitMax <= 0 || wait < retryPolicy.WaitMax); i++ {
+		wait *= 2
+	}
+
+	if retryPolicy.WaitMax > 0 && wait > retryPolicy.WaitMax {
+		wait = retryPolicy.WaitMax
+	}
+
+	if wait <= 0 {
+		return 0
+	}
+
+	half := wait / 2
+
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
+
+// Returns a human readable reason if the given attempt should be retried, or an empty string if it should not.
+func (retryPolicy RetryPolicy) retryReason(request *http.Request, response *http.Response, err error, attempt int) string {
+	if attempt >= retryPolicy.MaxRetries || !isIdempotentMethod(request.Method) {
+		return ""
+	}
+
+	if err != nil {
+		if isRetryableError(err) {
+			return err.Error()
+		}
+
+		return ""
+	}
+
+	if retryableStatusCodes[response.StatusCode] {
+		return response.Status
+	}
+
+	return ""
+}
+
+// Sends an HTTP request, retrying idempotent requests that fail with a transient error according to the client's retry policy
+func (keycloakClient *KeycloakClient) doWithRetry(ctx context.Context, request *http.Request, body []byte, token accessToken) (*http.Response, error) {
+	retryPolicy := keycloakClient.retryPolicy
+
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			request.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		keycloakClient.addRequestHeaders(request, token)
+
+		response, err := keycloakClient.httpClient.Do(request)
+
+		reason := retryPolicy.retryReason(request, response, err, attempt)
+		if reason == "" {
+			return response, err
+		}
+
+		wait := retryPolicy.backoff(attempt, response)
+
+		if response != nil {
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		log.Printf("[DEBUG] %s to %s failed: %s. Retrying in %s (retry %d of %d)", request.Method, request.URL.Path, reason, wait, attempt+1, retryPolicy.MaxRetries)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Starts a stand-in for Keycloak which answers logins and server info requests, and passes everything else to the given handler
+func newTestKeycloakServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/realms/master/protocol/openid-connect/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "access", "refresh_token": "refresh", "token_type": "bearer"}`))
+	})
+	mux.HandleFunc("/auth/admin/serverinfo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"systemInfo": {"version": "12.0.4"}}`))
+	})
+	mux.HandleFunc("/auth/admin/realms/test", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newRetryTestClient(t *testing.T, retryPolicy RetryPolicy, handler http.HandlerFunc) *KeycloakClient {
+	server := newTestKeycloakServer(t, handler)
+
+	keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{}, "", nil, retryPolicy, RateLimit{}, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("error creating keycloak client: %s", err)
+	}
+
+	return keycloakClient
+}
+
+func TestRetryOnTransientStatusCodes(t *testing.T) {
+	for _, statusCode := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusTooManyRequests} {
+		var attempts int32
+
+		keycloakClient := newRetryTestClient(t, RetryPolicy{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: 5 * time.Millisecond}, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				w.WriteHeader(statusCode)
+				return
+			}
+
+			w.Write([]byte(`{"id": "test", "realm": "test"}`))
+		})
+
+		var realm Realm
+		err := keycloakClient.get(testCtx, "/realms/test", &realm, nil)
+		if err != nil {
+			t.Fatalf("expected request to succeed after retrying %d, got %s", statusCode, err)
+		}
+		if realm.Realm != "test" {
+			t.Fatalf("expected realm test, got %s", realm.Realm)
+		}
+		if attempts != 3 {
+			t.Fatalf("expected 3 attempts for status %d, got %d", statusCode, attempts)
+		}
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	var attempts int32
+
+	keycloakClient := newRetryTestClient(t, RetryPolicy{MaxRetries: 2, WaitMin: time.Millisecond, WaitMax: time.Millisecond}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := keycloakClient.getRaw(testCtx, "/realms/test", nil)
+	if err == nil {
+		t.Fatal("expected request to fail")
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 1 attempt and 2 retries, got %d attempts", attempts)
+	}
+}
+
+func TestRetryDoesNotRetryPost(t *testing.T) {
+	var attempts int32
+
+	keycloakClient := newRetryTestClient(t, RetryPolicy{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: time.Millisecond}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, _, err := keycloakClient.post(testCtx, "/realms/test", map[string]string{})
+	if err == nil {
+		t.Fatal("expected request to fail")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected POST to be sent once, got %d attempts", attempts)
+	}
+}
+
+func TestRetryDoesNotRetryClientErrors(t *testing.T) {
+	var attempts int32
+
+	keycloakClient := newRetryTestClient(t, RetryPolicy{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: time.Millisecond}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := keycloakClient.getRaw(testCtx, "/realms/test", nil)
+	if !ErrorIs404(err) {
+		t.Fatalf("expected 404 error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 404 to not be retried, got %d attempts", attempts)
+	}
+}
+
+func TestRetryOnConnectionReset(t *testing.T) {
+	var attempts int32
+
+	keycloakClient := newRetryTestClient(t, RetryPolicy{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: time.Millisecond}, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("error hijacking connection: %s", err)
+				return
+			}
+			conn.Close()
+			return
+		}
+
+		w.Write([]byte(`{"id": "test", "realm": "test"}`))
+	})
+
+	_, err := keycloakClient.getRaw(testCtx, "/realms/test", nil)
+	if err != nil {
+		t.Fatalf("expected request to succeed after a dropped connection, got %s", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	retryPolicy := RetryPolicy{MaxRetries: 10, WaitMin: 100 * time.Millisecond, WaitMax: time.Second}
+
+	for attempt, expectedMax := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		wait := retryPolicy.backoff(attempt, nil)
+		if wait < expectedMax/2 || wait > expectedMax {
+			t.Fatalf("expected wait for attempt %d to be between %s and %s, got %s", attempt, expectedMax/2, expectedMax, wait)
+		}
+	}
+}
+
+func TestRetryBackoffHonorsRetryAfter(t *testing.T) {
+	retryPolicy := RetryPolicy{MaxRetries: 3, WaitMin: 100 * time.Millisecond, WaitMax: 10 * time.Second}
+
+	response := &http.Response{Header: http.Header{}}
+	response.Header.Set("Retry-After", "7")
+
+	if wait := retryPolicy.backoff(0, response); wait != 7*time.Second {
+		t.Fatalf("expected Retry-After of 7s to be honored, got %s", wait)
+	}
+
+	response.Header.Set("Retry-After", "120")
+
+	if wait := retryPolicy.backoff(0, response); wait != retryPolicy.WaitMax {
+		t.Fatalf("expected Retry-After to be capped at %s, got %s", retryPolicy.WaitMax, wait)
+	}
+
+	response.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+
+	if wait := retryPolicy.backoff(0, response); wait != 0 {
+		t.Fatalf("expected Retry-After date in the past to not wait, got %s", wait)
+	}
+}
+
+func TestRetryStopsWhenContextIsCancelled(t *testing.T) {
+	keycloakClient := newRetryTestClient(t, RetryPolicy{MaxRetries: 3, WaitMin: time.Hour, WaitMax: time.Hour}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(testCtx, 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, err := keycloakClient.getRaw(ctx, "/realms/test", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to be cancelled while waiting for a retry, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the request to be cancelled right away, took %s", elapsed)
+	}
+}
+
+func TestInFlightRequestIsCancelled(t *testing.T) {
+	keycloakClient := newRetryTestClient(t, RetryPolicy{}, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(testCtx)
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := keycloakClient.getRaw(ctx, "/realms/test", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the in-flight request to be cancelled, got %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const (
+	samlHttpPostBinding     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlHttpRedirectBinding = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+)
+
+// SamlIdentityProviderMetadata is the part of the metadata of a SAML identity provider that is used to configure it in
+// Keycloak. Unlike Keycloak's own import, every signing certificate and NameID format is kept.
+type SamlIdentityProviderMetadata struct {
+	EntityId                string
+	SingleSignOnServiceUrl  string
+	SingleLogoutServiceUrl  string
+	PostBindingResponse     bool
+	PostBindingLogout       bool
+	WantAuthnRequestsSigned bool
+	SigningCertificates     []string
+	NameIdFormats           []string
+}
+
+// Metadata documents either describe a single entity, or a group of them that can be nested. As the child elements
+// of both don't overlap, one struct is used for either.
+type samlMetadataElement struct {
+	XMLName             xml.Name
+	EntityId            string                 `xml:"entityID,attr"`
+	IdpSsoDescriptors   []samlIdpSsoDescriptor `xml:"IDPSSODescriptor"`
+	EntityDescriptors   []samlMetadataElement  `xml:"EntityDescriptor"`
+	EntitiesDescriptors []samlMetadataElement  `xml:"EntitiesDescriptor"`
+}
+
+type samlIdpSsoDescriptor struct {
+	WantAuthnRequestsSigned bool                `xml:"WantAuthnRequestsSigned,attr"`
+	KeyDescriptors          []samlKeyDescriptor `xml:"KeyDescriptor"`
+	SingleLogoutServices    []samlEndpoint      `xml:"SingleLogoutService"`
+	SingleSignOnServices    []samlEndpoint      `xml:"SingleSignOnService"`
+	NameIdFormats           []string            `xml:"NameIDFormat"`
+}
+
+type samlKeyDescriptor struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+func (element samlMetadataElement) identityProviders() []samlMetadataElement {
+	var result []samlMetadataElement
+	if element.XMLName.Local == "EntityDescriptor" && len(element.IdpSsoDescriptors) != 0 {
+		result = append(result, element)
+	}
+
+	for _, child := range element.EntityDescriptors {
+		result = append(result, child.identityProviders()...)
+	}
+	for _, child := range element.EntitiesDescriptors {
+		result = append(result, child.identityProviders()...)
+	}
+
+	return result
+}
+
+// Picks the endpoint Keycloak would use, which is the HTTP-POST one if there is one, and the HTTP-Redirect one otherwise
+func selectSamlEndpoint(endpoints []samlEndpoint) (string, bool) {
+	location := ""
+	for _, endpoint := range endpoints {
+		if endpoint.Binding == samlHttpPostBinding {
+			return endpoint.Location, true
+		}
+		if endpoint.Binding == samlHttpRedirectBinding && location == "" {
+			location = endpoint.Location
+		}
+	}
+
+	return location, false
+}
+
+// ParseSamlIdentityProviderMetadata parses the metadata of a SAML identity provider. If the metadata describes more than
+// one identity provider, the entity id has to be given to select one of them.
+func ParseSamlIdentityProviderMetadata(metadata []byte, entityId string) (*SamlIdentityProviderMetadata, error) {
+	var root samlMetadataElement
+	if err := xml.Unmarshal(metadata, &root); err != nil {
+		return nil, fmt.Errorf("unable to parse SAML metadata: %w", err)
+	}
+	if root.XMLName.Local != "EntityDescriptor" && root.XMLName.Local != "EntitiesDescriptor" {
+		return nil, fmt.Errorf("unable to parse SAML metadata: expected an EntityDescriptor or EntitiesDescriptor element, got %s", root.XMLName.Local)
+	}
+
+	var entity *samlMetadataElement
+	identityProviders := root.identityProviders()
+	for i := range identityProviders {
+		if entityId == "" || identityProviders[i].EntityId == entityId {
+			if entity != nil {
+				return nil, fmt.Errorf("the SAML metadata describes more than one identity provider, an entity id has to be given to select one of them")
+			}
+			entity = &identityProviders[i]
+		}
+	}
+	if entity == nil {
+		if entityId != "" {
+			return nil, fmt.Errorf("the SAML metadata doesn't describe an identity provider with entity id %s", entityId)
+		}
+		return nil, fmt.Errorf("the SAML metadata doesn't describe an identity provider")
+	}
+
+	descriptor := entity.IdpSsoDescriptors[0]
+	result := &SamlIdentityProviderMetadata{
+		EntityId:                entity.EntityId,
+		WantAuthnRequestsSigned: descriptor.WantAuthnRequestsSigned,
+		SigningCertificates:     []string{},
+		NameIdFormats:           []string{},
+	}
+	result.SingleSignOnServiceUrl, result.PostBindingResponse = selectSamlEndpoint(descriptor.SingleSignOnServices)
+	result.SingleLogoutServiceUrl, result.PostBindingLogout = selectSamlEndpoint(descriptor.SingleLogoutServices)
+
+	// keys without a use are used for both signing and encryption
+	for _, keyDescriptor := range descriptor.KeyDescriptors {
+		if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+			continue
+		}
+		for _, certificate := range keyDescriptor.Certificates {
+			certificate = strings.Join(strings.Fields(certificate), "")
+			if certificate != "" && !contains(result.SigningCertificates, certificate) {
+				result.SigningCertificates = append(result.SigningCertificates, certificate)
+			}
+		}
+	}
+	for _, format := range descriptor.NameIdFormats {
+		if format = strings.TrimSpace(format); format != "" {
+			result.NameIdFormats = append(result.NameIdFormats, format)
+		}
+	}
+
+	if result.SingleSignOnServiceUrl == "" {
+		return nil, fmt.Errorf("the SAML metadata of %s doesn't contain an HTTP-POST or HTTP-Redirect single sign on service", result.EntityId)
+	}
+
+	return result, nil
+}
+
+// FetchSamlIdentityProviderMetadata downloads the metadata of a SAML identity provider. The metadata is fetched by the
+// provider rather than by Keycloak, without any of the settings used to connect to Keycloak.
+func (keycloakClient *KeycloakClient) FetchSamlIdentityProviderMetadata(ctx context.Context, metadataUrl string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/samlmetadata+xml, application/xml, text/xml")
+
+	log.Printf("[DEBUG] Fetching SAML metadata from %s", metadataUrl)
+
+	httpClient := &http.Client{
+		Timeout: keycloakClient.httpClient.Timeout,
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching SAML metadata: %w", err)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching SAML metadata: %w", err)
+	}
+	if response.StatusCode >= 400 {
+		return nil, fmt.Errorf("error fetching SAML metadata from %s: %s", metadataUrl, response.Status)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,111 @@
+package keycloak
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSamlIdentityProviderMetadata = `<?xml version="1.0" encoding="UTF-8"?>
+<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+  <md:EntityDescriptor entityID="https://sp.example.com">
+    <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+      <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs" index="0"/>
+    </md:SPSSODescriptor>
+  </md:EntityDescriptor>
+  <md:EntitiesDescriptor>
+    <md:EntityDescriptor entityID="https://idp.example.com">
+      <md:IDPSSODescriptor WantAuthnRequestsSigned="true" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+        <md:KeyDescriptor use="signing">
+          <ds:KeyInfo>
+            <ds:X509Data>
+              <ds:X509Certificate>
+                MIIBsigningOne
+                AAAA
+              </ds:X509Certificate>
+            </ds:X509Data>
+          </ds:KeyInfo>
+        </md:KeyDescriptor>
+        <md:KeyDescriptor use="encryption">
+          <ds:KeyInfo><ds:X509Data><ds:X509Certificate>MIIBencryption</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+        </md:KeyDescriptor>
+        <md:KeyDescriptor>
+          <ds:KeyInfo><ds:X509Data><ds:X509Certificate>MIIBsigningTwo</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+        </md:KeyDescriptor>
+        <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/slo"/>
+        <md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:persistent</md:NameIDFormat>
+        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</md:NameIDFormat>
+        <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso/redirect"/>
+        <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso/post"/>
+      </md:IDPSSODescriptor>
+    </md:EntityDescriptor>
+  </md:EntitiesDescriptor>
+</md:EntitiesDescriptor>`
+
+func TestParseSamlIdentityProviderMetadata(t *testing.T) {
+	expected := &SamlIdentityProviderMetadata{
+		EntityId:                "https://idp.example.com",
+		SingleSignOnServiceUrl:  "https://idp.example.com/sso/post",
+		SingleLogoutServiceUrl:  "https://idp.example.com/slo",
+		PostBindingResponse:     true,
+		PostBindingLogout:       false,
+		WantAuthnRequestsSigned: true,
+		SigningCertificates:     []string{"MIIBsigningOneAAAA", "MIIBsigningTwo"},
+		NameIdFormats: []string{
+			"urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+			"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		},
+	}
+
+	for _, entityId := range []string{"", "https://idp.example.com"} {
+		metadata, err := ParseSamlIdentityProviderMetadata([]byte(testSamlIdentityProviderMetadata), entityId)
+		if err != nil {
+			t.Fatalf("unexpected error parsing metadata with entity id %q: %s", entityId, err)
+		}
+		if !reflect.DeepEqual(metadata, expected) {
+			t.Errorf("expected %+v, got %+v", expected, metadata)
+		}
+	}
+}
+
+func TestParseSamlIdentityProviderMetadata_errors(t *testing.T) {
+	secondIdentityProvider := strings.Replace(testSamlIdentityProviderMetadata, "https://sp.example.com", "https://other-idp.example.com", 1)
+	secondIdentityProvider = strings.Replace(secondIdentityProvider, "SPSSODescriptor", "IDPSSODescriptor", -1)
+
+	tests := map[string]struct {
+		metadata string
+		entityId string
+		message  string
+	}{
+		"not xml": {
+			metadata: "{}",
+			message:  "unable to parse SAML metadata",
+		},
+		"not metadata": {
+			metadata: "<html></html>",
+			message:  "expected an EntityDescriptor or EntitiesDescriptor element, got html",
+		},
+		"unknown entity id": {
+			metadata: testSamlIdentityProviderMetadata,
+			entityId: "https://sp.example.com",
+			message:  "doesn't describe an identity provider with entity id https://sp.example.com",
+		},
+		"ambiguous": {
+			metadata: secondIdentityProvider,
+			message:  "an entity id has to be given",
+		},
+		"no single sign on service": {
+			metadata: `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="idp"><IDPSSODescriptor/></EntityDescriptor>`,
+			message:  "doesn't contain an HTTP-POST or HTTP-Redirect single sign on service",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSamlIdentityProviderMetadata([]byte(test.metadata), test.entityId)
+			if err == nil || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("expected an error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package keycloak
+
+import (
+	"context"
+	"time"
+)
+
+// Access tokens are refreshed shortly before they expire, so requests don't have to fail with a 401 first.
+// The margin is a tenth of the token's lifetime, but never more than maxTokenRefreshMargin.
+const maxTokenRefreshMargin = 30 * time.Second
+
+// A snapshot of the access token used for a single request. The generation is incremented every time the token is
+// replaced, which lets a request that was rejected with a token tell whether that token has already been refreshed.
+type accessToken struct {
+	tokenType  string
+	value      string
+	generation uint64
+	refreshAt  time.Time
+}
+
+func (token accessToken) needsRefresh() bool {
+	return !token.refreshAt.IsZero() && !time.Now().Before(token.refreshAt)
+}
+
+func (keycloakClient *KeycloakClient) currentToken() accessToken {
+	keycloakClient.tokenMutex.RLock()
+	defer keycloakClient.tokenMutex.RUnlock()
+
+	return accessToken{
+		tokenType:  keycloakClient.clientCredentials.TokenType,
+		value:      keycloakClient.clientCredentials.AccessToken,
+		generation: keycloakClient.tokenGeneration,
+		refreshAt:  keycloakClient.tokenRefreshAt,
+	}
+}
+
+func (keycloakClient *KeycloakClient) setToken(clientCredentials ClientCredentials) {
+	keycloakClient.tokenMutex.Lock()
+	defer keycloakClient.tokenMutex.Unlock()
+
+	keycloakClient.clientCredentials.AccessToken = clientCredentials.AccessToken
+	keycloakClient.clientCredentials.RefreshToken = clientCredentials.RefreshToken
+	keycloakClient.clientCredentials.TokenType = clientCredentials.TokenType
+	keycloakClient.tokenGeneration++
+
+	if clientCredentials.ExpiresIn > 0 {
+		lifetime := time.Duration(clientCredentials.ExpiresIn) * time.Second
+
+		margin := lifetime / 10
+		if margin > maxTokenRefreshMargin {
+			margin = maxTokenRefreshMargin
+		}
+
+		keycloakClient.tokenRefreshAt = time.Now().Add(lifetime - margin)
+	} else {
+		keycloakClient.tokenRefreshAt = time.Time{}
+	}
+}
+
+// Returns a token that can be used for the next request, logging in or refreshing first if necessary.
+// Concurrent callers wait for a single login or refresh instead of each replacing the tokens of the others.
+func (keycloakClient *KeycloakClient) ensureAuthenticated(ctx context.Context) (accessToken, error) {
+	token := keycloakClient.currentToken()
+
+	if token.generation == 0 {
+		keycloakClient.loginMutex.Lock()
+		defer keycloakClient.loginMutex.Unlock()
+
+		if keycloakClient.currentToken().generation == 0 {
+			err := keycloakClient.login(ctx)
+			if err != nil {
+				return accessToken{}, err
+			}
+		}
+
+		return keycloakClient.currentToken(), nil
+	}
+
+	if token.needsRefresh() {
+		err := keycloakClient.refreshToken(ctx, token)
+		if err != nil {
+			return accessToken{}, err
+		}
+
+		return keycloakClient.currentToken(), nil
+	}
+
+	return token, nil
+}
+
+// Refreshes the given token, unless another goroutine has already replaced it while we were waiting for the lock
+func (keycloakClient *KeycloakClient) refreshToken(ctx context.Context, staleToken accessToken) error {
+	keycloakClient.loginMutex.Lock()
+	defer keycloakClient.loginMutex.Unlock()
+
+	if keycloakClient.currentToken().generation != staleToken.generation {
+		return nil
+	}
+
+	return keycloakClient.refresh(ctx)
+}
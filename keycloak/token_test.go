@@ -0,0 +1,152 @@
+package keycloak
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// A stand-in for Keycloak that only accepts the most recently issued access token
+type tokenTestServer struct {
+	*httptest.Server
+
+	mutex        sync.Mutex
+	issued       int
+	issuedAt     time.Time
+	validToken   string
+	expiresIn    int
+	tokenCalls   int32
+	unauthorized int32
+}
+
+func newTokenTestServer(t *testing.T, expiresIn int) *tokenTestServer {
+	server := &tokenTestServer{
+		expiresIn: expiresIn,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/realms/master/protocol/openid-connect/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&server.tokenCalls, 1)
+
+		// give concurrent requests a chance to pile up behind a slow token endpoint
+		time.Sleep(10 * time.Millisecond)
+
+		server.mutex.Lock()
+		server.issued++
+		server.validToken = fmt.Sprintf("token-%d", server.issued)
+		server.issuedAt = time.Now()
+		token := server.validToken
+		server.mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "%s", "refresh_token": "refresh-%s", "token_type": "bearer", "expires_in": %d}`, token, token, server.expiresIn)
+	})
+	mux.HandleFunc("/auth/admin/", func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		expired := time.Since(server.issuedAt) > time.Duration(server.expiresIn)*time.Second
+		valid := r.Header.Get("Authorization") == "bearer "+server.validToken && !expired
+		server.mutex.Unlock()
+
+		if !valid {
+			atomic.AddInt32(&server.unauthorized, 1)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/auth/admin/serverinfo" {
+			w.Write([]byte(`{"systemInfo": {"version": "12.0.4"}}`))
+			return
+		}
+
+		w.Write([]byte(`{"id": "test", "realm": "test"}`))
+	})
+
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// Invalidates the current access token, as if it had expired or had been revoked
+func (server *tokenTestServer) revokeToken() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.validToken = "revoked"
+}
+
+func newTokenTestClient(t *testing.T, server *tokenTestServer, initialLogin bool) *KeycloakClient {
+	keycloakClient, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "secret", "master", "", "", initialLogin, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{})
+	if err != nil {
+		t.Fatalf("error creating keycloak client: %s", err)
+	}
+
+	return keycloakClient
+}
+
+func hammer(t *testing.T, keycloakClient *KeycloakClient, goroutines int) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var realm Realm
+			if err := keycloakClient.get(testCtx, "/realms/test", &realm, nil); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			keycloakClient.VersionIsGreaterThanOrEqualTo(Version_12)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestConcurrentRequestsLogInOnce(t *testing.T) {
+	server := newTokenTestServer(t, 300)
+	keycloakClient := newTokenTestClient(t, server, false)
+
+	hammer(t, keycloakClient, 50)
+
+	if atomic.LoadInt32(&server.tokenCalls) != 1 {
+		t.Fatalf("expected a single login, got %d calls to the token endpoint", atomic.LoadInt32(&server.tokenCalls))
+	}
+}
+
+func TestConcurrentUnauthorizedResponsesRefreshOnce(t *testing.T) {
+	server := newTokenTestServer(t, 300)
+	keycloakClient := newTokenTestClient(t, server, true)
+
+	for round := 1; round <= 5; round++ {
+		server.revokeToken()
+
+		hammer(t, keycloakClient, 50)
+
+		if expected := int32(round + 1); atomic.LoadInt32(&server.tokenCalls) != expected {
+			t.Fatalf("expected a single refresh per revoked token, got %d calls to the token endpoint after %d revocations", atomic.LoadInt32(&server.tokenCalls), round)
+		}
+	}
+}
+
+func TestTokenIsRefreshedBeforeItExpires(t *testing.T) {
+	server := newTokenTestServer(t, 1)
+	keycloakClient := newTokenTestClient(t, server, true)
+
+	time.Sleep(time.Second)
+
+	hammer(t, keycloakClient, 20)
+
+	if atomic.LoadInt32(&server.unauthorized) != 0 {
+		t.Fatalf("expected the token to be refreshed before it expired, got %d unauthorized responses", atomic.LoadInt32(&server.unauthorized))
+	}
+	if atomic.LoadInt32(&server.tokenCalls) != 2 {
+		t.Fatalf("expected login and a single refresh, got %d calls to the token endpoint", atomic.LoadInt32(&server.tokenCalls))
+	}
+}
@@ -0,0 +1,158 @@
+package keycloak
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TransportOptions controls how the client connects to Keycloak. All options can be combined with each other.
+type TransportOptions struct {
+	// PEM encoded CA certificates (or a path to a file containing them), trusted in addition to the system's CAs
+	RootCaCertificate     string
+	TlsInsecureSkipVerify bool
+	TlsClientCertificate  string
+	TlsClientPrivateKey   string
+	// One of "1.0", "1.1", "1.2" or "1.3". Go's default is used when empty
+	TlsMinVersion string
+	// Proxy used for all requests. When empty, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used
+	ProxyUrl            string
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	// Records the traffic with Keycloak, or replays a recording, which is used by the acceptance tests
+	Recording Recording
+}
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// PEM encoded values can either be passed inline, or as a path to a file containing them
+func readPemOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return ioutil.ReadFile(value)
+}
+
+func loadClientCertificates(clientCertificate, clientPrivateKey string) ([]tls.Certificate, error) {
+	if clientCertificate == "" && clientPrivateKey == "" {
+		return nil, nil
+	}
+
+	if clientCertificate == "" || clientPrivateKey == "" {
+		return nil, fmt.Errorf("a TLS client certificate and its private key must be specified together")
+	}
+
+	certificatePem, err := readPemOrFile(clientCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("error reading TLS client certificate: %s", err)
+	}
+
+	privateKeyPem, err := readPemOrFile(clientPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error reading TLS client private key: %s", err)
+	}
+
+	certificate, err := tls.X509KeyPair(certificatePem, privateKeyPem)
+	if err != nil {
+		return nil, fmt.Errorf("error loading TLS client certificate: %s", err)
+	}
+
+	return []tls.Certificate{certificate}, nil
+}
+
+func loadRootCAs(rootCaCertificate string) (*x509.CertPool, error) {
+	if rootCaCertificate == "" {
+		return nil, nil
+	}
+
+	caCertificatePem, err := readPemOrFile(rootCaCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("error reading root CA certificate: %s", err)
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil || rootCAs == nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	if !rootCAs.AppendCertsFromPEM(caCertificatePem) {
+		return nil, fmt.Errorf("error parsing root CA certificate: no valid PEM encoded certificates found")
+	}
+
+	return rootCAs, nil
+}
+
+func newTlsConfig(options TransportOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: options.TlsInsecureSkipVerify,
+	}
+
+	rootCAs, err := loadRootCAs(options.RootCaCertificate)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.RootCAs = rootCAs
+
+	clientCertificates, err := loadClientCertificates(options.TlsClientCertificate, options.TlsClientPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.Certificates = clientCertificates
+
+	if options.TlsMinVersion != "" {
+		minVersion, ok := tlsVersions[options.TlsMinVersion]
+		if !ok {
+			return nil, fmt.Errorf("invalid minimum TLS version %s, must be one of 1.0, 1.1, 1.2 or 1.3", options.TlsMinVersion)
+		}
+		tlsConfig.MinVersion = minVersion
+	}
+
+	return tlsConfig, nil
+}
+
+func newHttpTransport(options TransportOptions) (*http.Transport, error) {
+	tlsConfig, err := newTlsConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	// start from Go's defaults for dial and handshake timeouts, HTTP/2 support, etc.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = http.ProxyFromEnvironment
+
+	if options.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(options.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing proxy URL: %s", err)
+		}
+		if proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %s, expected a URL like http://proxy.example.com:3128", options.ProxyUrl)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if options.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = options.MaxIdleConnsPerHost
+		if transport.MaxIdleConns < options.MaxIdleConnsPerHost {
+			transport.MaxIdleConns = options.MaxIdleConnsPerHost
+		}
+	}
+
+	if options.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = options.IdleConnTimeout
+	}
+
+	return transport, nil
+}
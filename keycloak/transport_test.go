@@ -0,0 +1,232 @@
+package keycloak
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Generates a self-signed certificate and its private key, both PEM encoded
+func generateTestCertificate(t *testing.T, commonName string) (string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKeyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+	privateKeyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privateKeyDer})
+
+	return string(certificatePem), string(privateKeyPem)
+}
+
+func TestLoadClientCertificatesFromPem(t *testing.T) {
+	certificatePem, privateKeyPem := generateTestCertificate(t, "terraform")
+
+	certificates, err := loadClientCertificates(certificatePem, privateKeyPem)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(certificates) != 1 {
+		t.Fatalf("expected one certificate, got %d", len(certificates))
+	}
+}
+
+func TestLoadClientCertificatesFromFiles(t *testing.T) {
+	certificatePem, privateKeyPem := generateTestCertificate(t, "terraform")
+
+	dir := t.TempDir()
+	certificateFile := filepath.Join(dir, "client.crt")
+	privateKeyFile := filepath.Join(dir, "client.key")
+
+	if err := ioutil.WriteFile(certificateFile, []byte(certificatePem), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(privateKeyFile, []byte(privateKeyPem), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	certificates, err := loadClientCertificates(certificateFile, privateKeyFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(certificates) != 1 {
+		t.Fatalf("expected one certificate, got %d", len(certificates))
+	}
+}
+
+func TestLoadClientCertificatesValidation(t *testing.T) {
+	certificatePem, privateKeyPem := generateTestCertificate(t, "terraform")
+	_, otherPrivateKeyPem := generateTestCertificate(t, "other")
+
+	if certificates, err := loadClientCertificates("", ""); err != nil || certificates != nil {
+		t.Fatalf("expected no certificates and no error, got %v, %v", certificates, err)
+	}
+	if _, err := loadClientCertificates(certificatePem, ""); err == nil {
+		t.Fatal("expected an error for a certificate without a private key")
+	}
+	if _, err := loadClientCertificates("", privateKeyPem); err == nil {
+		t.Fatal("expected an error for a private key without a certificate")
+	}
+	if _, err := loadClientCertificates(certificatePem, otherPrivateKeyPem); err == nil {
+		t.Fatal("expected an error for a private key that does not match the certificate")
+	}
+	if _, err := loadClientCertificates(filepath.Join(t.TempDir(), "missing.crt"), privateKeyPem); err == nil {
+		t.Fatal("expected an error for a missing certificate file")
+	}
+}
+
+func TestClientPresentsCertificateForMutualTls(t *testing.T) {
+	certificatePem, privateKeyPem := generateTestCertificate(t, "terraform")
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "terraform" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/auth/realms/master/protocol/openid-connect/token":
+			w.Write([]byte(`{"access_token": "access", "refresh_token": "refresh", "token_type": "bearer"}`))
+		case "/auth/admin/serverinfo":
+			w.Write([]byte(`{"systemInfo": {"version": "12.0.4"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	if _, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{TlsInsecureSkipVerify: true}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{}); err == nil {
+		t.Fatal("expected login without a client certificate to fail")
+	}
+
+	if _, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{TlsInsecureSkipVerify: true, TlsClientCertificate: certificatePem, TlsClientPrivateKey: privateKeyPem}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{}); err != nil {
+		t.Fatalf("expected login with a client certificate to succeed, got %s", err)
+	}
+}
+
+func TestNewHttpTransportCombinesOptions(t *testing.T) {
+	caCertificatePem, _ := generateTestCertificate(t, "private-ca")
+	clientCertificatePem, clientPrivateKeyPem := generateTestCertificate(t, "terraform")
+
+	transport, err := newHttpTransport(TransportOptions{
+		RootCaCertificate:     caCertificatePem,
+		TlsInsecureSkipVerify: true,
+		TlsClientCertificate:  clientCertificatePem,
+		TlsClientPrivateKey:   clientPrivateKeyPem,
+		TlsMinVersion:         "1.2",
+		ProxyUrl:              "http://proxy.example.com:3128",
+		MaxIdleConnsPerHost:   25,
+		IdleConnTimeout:       time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if transport.TLSClientConfig.RootCAs == nil {
+		t.Fatal("expected root CA certificate to be trusted")
+	}
+	if !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Fatal("expected tls_insecure_skip_verify to be kept when a root CA certificate is specified")
+	}
+	if len(transport.TLSClientConfig.Certificates) != 1 {
+		t.Fatal("expected client certificate to be presented")
+	}
+	if transport.TLSClientConfig.MinVersion != tls.VersionTLS12 {
+		t.Fatalf("expected minimum TLS version 1.2, got %x", transport.TLSClientConfig.MinVersion)
+	}
+	if transport.MaxIdleConnsPerHost != 25 || transport.IdleConnTimeout != time.Minute {
+		t.Fatalf("expected keep-alive settings to be applied, got %d and %s", transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, "https://keycloak.example.com/auth", nil)
+	proxyUrl, err := transport.Proxy(request)
+	if err != nil || proxyUrl == nil || proxyUrl.String() != "http://proxy.example.com:3128" {
+		t.Fatalf("expected requests to be sent through the proxy, got %v, %v", proxyUrl, err)
+	}
+}
+
+func TestNewHttpTransportUsesProxyFromEnvironmentByDefault(t *testing.T) {
+	caCertificatePem, _ := generateTestCertificate(t, "private-ca")
+
+	transport, err := newHttpTransport(TransportOptions{
+		RootCaCertificate: caCertificatePem,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if transport.Proxy == nil {
+		t.Fatal("expected proxy settings from the environment to be used when a root CA certificate is specified")
+	}
+}
+
+func TestNewHttpTransportValidation(t *testing.T) {
+	for name, options := range map[string]TransportOptions{
+		"unparseable root CA":   {RootCaCertificate: "-----BEGIN CERTIFICATE-----\nnot a certificate\n-----END CERTIFICATE-----"},
+		"missing root CA file":  {RootCaCertificate: filepath.Join(t.TempDir(), "ca.crt")},
+		"invalid TLS version":   {TlsMinVersion: "1.4"},
+		"proxy URL w/o scheme":  {ProxyUrl: "proxy.example.com:3128"},
+		"client key w/o cert":   {TlsClientPrivateKey: "key.pem"},
+		"unparseable proxy URL": {ProxyUrl: "http://[::1"},
+	} {
+		if _, err := newHttpTransport(options); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestClientTrustsRootCaCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/auth/realms/master/protocol/openid-connect/token":
+			w.Write([]byte(`{"access_token": "access", "refresh_token": "refresh", "token_type": "bearer"}`))
+		case "/auth/admin/serverinfo":
+			w.Write([]byte(`{"systemInfo": {"version": "12.0.4"}}`))
+		}
+	}))
+	defer server.Close()
+
+	serverCertificatePem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	if _, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{}); err == nil {
+		t.Fatal("expected login to fail when the server certificate is not trusted")
+	}
+
+	if _, err := NewKeycloakClient(testCtx, server.URL, "/auth", "admin-cli", "secret", "master", "", "", true, 5, TransportOptions{RootCaCertificate: serverCertificatePem}, "", nil, RetryPolicy{}, RateLimit{}, ClientAssertion{}, ExternalToken{}); err != nil {
+		t.Fatalf("expected login to succeed with the server certificate as root CA, got %s", err)
+	}
+}
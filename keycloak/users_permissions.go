@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -15,18 +16,18 @@ type UsersPermissions struct {
 	ScopePermissions map[string]interface{} `json:"scopePermissions"`
 }
 
-func (keycloakClient *KeycloakClient) EnableUsersPermissions(realmId string) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/users-management-permissions", realmId), UsersPermissionsInput{Enabled: true})
+func (keycloakClient *KeycloakClient) EnableUsersPermissions(ctx context.Context, realmId string) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users-management-permissions", realmId), UsersPermissionsInput{Enabled: true})
 }
 
-func (keycloakClient *KeycloakClient) DisableUsersPermissions(realmId string) error {
-	return keycloakClient.put(fmt.Sprintf("/realms/%s/users-management-permissions", realmId), UsersPermissionsInput{Enabled: false})
+func (keycloakClient *KeycloakClient) DisableUsersPermissions(ctx context.Context, realmId string) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users-management-permissions", realmId), UsersPermissionsInput{Enabled: false})
 }
 
-func (keycloakClient *KeycloakClient) GetUsersPermissions(realmId string) (*UsersPermissions, error) {
+func (keycloakClient *KeycloakClient) GetUsersPermissions(ctx context.Context, realmId string) (*UsersPermissions, error) {
 	var openidClientPermissions UsersPermissions
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users-management-permissions", realmId), &openidClientPermissions, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users-management-permissions", realmId), &openidClientPermissions, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,12 @@
 package keycloak
 
-import "github.com/hashicorp/go-version"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
 
 type Version string
 
@@ -12,10 +18,65 @@ const (
 	Version_10 Version = "10.0.0"
 	Version_11 Version = "11.0.0"
 	Version_12 Version = "12.0.0"
+	Version_13 Version = "13.0.0"
+	Version_14 Version = "14.0.0"
+	Version_15 Version = "15.0.0"
+	Version_16 Version = "16.0.0"
+	Version_17 Version = "17.0.0"
+	Version_18 Version = "18.0.0"
+	Version_19 Version = "19.0.0"
+	Version_20 Version = "20.0.0"
+	Version_21 Version = "21.0.0"
+	Version_22 Version = "22.0.0"
+	Version_23 Version = "23.0.0"
+	Version_24 Version = "24.0.0"
+	Version_25 Version = "25.0.0"
+	Version_26 Version = "26.0.0"
 )
 
+// Matches the numeric part of the versions reported by the server, such as "12.0.4", "7.4.0.GA", "26.0.0-SNAPSHOT"
+// or "22.0.7.redhat-00001". Anything after it is ignored, so snapshots and vendor builds are gated like the release
+// they belong to.
+var serverVersionRegex = regexp.MustCompile(`^(\d+)(\.\d+)?(\.\d+)?`)
+
+// Red Hat Single Sign-On reports its own product version, which is mapped to the Keycloak release it is based on
+var redHatSsoVersions = map[string]Version{
+	"7.0": "1.9.0",
+	"7.1": "2.5.0",
+	"7.2": "3.4.0",
+	"7.3": "4.8.0",
+	"7.4": "9.0.0",
+	"7.5": "15.0.0",
+	"7.6": "18.0.0",
+}
+
+func parseServerVersion(serverVersion string) (*version.Version, error) {
+	serverVersion = strings.TrimSpace(serverVersion)
+
+	match := serverVersionRegex.FindStringSubmatch(serverVersion)
+	if match == nil {
+		return nil, fmt.Errorf("unable to parse Keycloak server version %q", serverVersion)
+	}
+
+	if strings.HasSuffix(serverVersion, ".GA") {
+		if keycloakVersion, ok := redHatSsoVersions[match[1]+match[2]]; ok {
+			return version.NewVersion(string(keycloakVersion))
+		}
+	}
+
+	return version.NewVersion(match[0])
+}
+
 func (keycloakClient *KeycloakClient) VersionIsGreaterThanOrEqualTo(versionString Version) bool {
 	v, _ := version.NewVersion(string(versionString))
 
+	keycloakClient.tokenMutex.RLock()
+	defer keycloakClient.tokenMutex.RUnlock()
+
+	// the version is only known after logging in
+	if keycloakClient.version == nil {
+		return false
+	}
+
 	return keycloakClient.version.GreaterThanOrEqual(v)
 }
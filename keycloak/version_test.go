@@ -0,0 +1,78 @@
+package keycloak
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	for serverVersion, expected := range map[string]string{
+		"12.0.4":              "12.0.4",
+		"21.1.2":              "21.1.2",
+		"26.0.0-SNAPSHOT":     "26.0.0",
+		"999.0.0-SNAPSHOT":    "999.0.0",
+		"22.0.7.redhat-00001": "22.0.7",
+		"4.8.3.Final":         "4.8.3",
+		"7.4.0.GA":            "9.0.0",
+		"7.6.2.GA":            "18.0.0",
+		"17":                  "17.0.0",
+	} {
+		v, err := parseServerVersion(serverVersion)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", serverVersion, err)
+			continue
+		}
+		if !v.Equal(version.Must(version.NewVersion(expected))) {
+			t.Errorf("expected %s to be parsed as %s, got %s", serverVersion, expected, v)
+		}
+	}
+
+	for _, serverVersion := range []string{"", "unknown", "v12"} {
+		if _, err := parseServerVersion(serverVersion); err == nil {
+			t.Errorf("expected an error parsing %q", serverVersion)
+		}
+	}
+}
+
+func TestParseRedHatSsoVersion(t *testing.T) {
+	for productVersion, keycloakVersion := range redHatSsoVersions {
+		v, err := parseServerVersion(productVersion + ".0.GA")
+		if err != nil {
+			t.Errorf("unexpected error parsing %s.0.GA: %s", productVersion, err)
+			continue
+		}
+		if !v.Equal(version.Must(version.NewVersion(string(keycloakVersion)))) {
+			t.Errorf("expected Red Hat SSO %s to be parsed as Keycloak %s, got %s", productVersion, keycloakVersion, v)
+		}
+	}
+
+	// product versions that aren't known, and versions without the GA suffix, are parsed as they are
+	for serverVersion, expected := range map[string]string{
+		"7.9.0.GA": "7.9.0",
+		"7.4.0":    "7.4.0",
+	} {
+		v, err := parseServerVersion(serverVersion)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", serverVersion, err)
+			continue
+		}
+		if !v.Equal(version.Must(version.NewVersion(expected))) {
+			t.Errorf("expected %s to be parsed as %s, got %s", serverVersion, expected, v)
+		}
+	}
+}
+
+func TestVersionIsGreaterThanOrEqualTo(t *testing.T) {
+	keycloakClient := &KeycloakClient{}
+
+	if keycloakClient.VersionIsGreaterThanOrEqualTo(Version_6) {
+		t.Error("expected an unknown version not to be greater than any other")
+	}
+
+	keycloakClient.version, _ = parseServerVersion("26.0.0-SNAPSHOT")
+
+	if !keycloakClient.VersionIsGreaterThanOrEqualTo(Version_26) || !keycloakClient.VersionIsGreaterThanOrEqualTo(Version_17) {
+		t.Error("expected a snapshot to be gated like its release")
+	}
+}
@@ -41,7 +41,7 @@ func testAccCheckDataKeycloakAuthenticationFlow(resourceName string) resource.Te
 		id := rs.Primary.ID
 		realmID := rs.Primary.Attributes["realm_id"]
 
-		authenticationFlow, err := keycloakClient.GetAuthenticationFlow(realmID, id)
+		authenticationFlow, err := keycloakClient.GetAuthenticationFlow(testCtx, realmID, id)
 		if err != nil {
 			return err
 		}
@@ -74,7 +74,7 @@ func testAccCheckDataKeycloakGroup(resourceName string) resource.TestCheckFunc {
 		realmId := rs.Primary.Attributes["realm_id"]
 		name := rs.Primary.Attributes["name"]
 
-		group, err := keycloakClient.GetGroup(realmId, id)
+		group, err := keycloakClient.GetGroup(testCtx, realmId, id)
 		if err != nil {
 			return err
 		}
@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
@@ -60,7 +63,7 @@ func dataSourceKeycloakRealm() *schema.Resource {
 		},
 	}
 	return &schema.Resource{
-		Read: dataSourceKeycloakRealmRead,
+		ReadContext: dataSourceKeycloakRealmRead,
 		Schema: map[string]*schema.Schema{
 			"realm": {
 				Type:     schema.TypeString,
@@ -86,6 +89,14 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"organizations_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"admin_permissions_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 
 			// Login Config
 
@@ -435,18 +446,119 @@ func dataSourceKeycloakRealm() *schema.Resource {
 					Schema: webAuthnSchema,
 				},
 			},
+
+			// OTP
+			"otp_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"digits": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"look_ahead_window": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"initial_counter": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"code_reusable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supported_applications": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// CIBA
+			"ciba_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backchannel_token_delivery_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expires_in": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"auth_requested_user_hint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// OAuth 2.0 Device Authorization Grant
+			"oauth2_device_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_lifespan": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"polling_interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// Pushed Authorization Requests
+			"par_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_uri_lifespan": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func dataSourceKeycloakRealmRead(data *schema.ResourceData, meta interface{}) error {
+func dataSourceKeycloakRealmRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmName := data.Get("realm").(string)
 
-	realm, err := keycloakClient.GetRealm(realmName)
+	realm, err := keycloakClient.GetRealm(ctx, realmName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setRealmData(data, realm)
@@ -1,15 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func dataSourceKeycloakRealmKeys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceKeycloakRealmKeysRead,
+		ReadContext: dataSourceKeycloakRealmKeysRead,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -123,14 +125,14 @@ func setRealmKeysData(data *schema.ResourceData, keys *keycloak.Keys) error {
 	return nil
 }
 
-func dataSourceKeycloakRealmKeysRead(data *schema.ResourceData, meta interface{}) error {
+func dataSourceKeycloakRealmKeysRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 
-	keys, err := keycloakClient.GetRealmKeys(realmId)
+	keys, err := keycloakClient.GetRealmKeys(ctx, realmId)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	if filterStatus, ok := data.GetOkExists("status"); ok {
@@ -142,12 +144,14 @@ func dataSourceKeycloakRealmKeysRead(data *schema.ResourceData, meta interface{}
 	}
 
 	if len(keys.Keys) == 0 {
-		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+		return diag.Errorf("Your query returned no results. Please change your search criteria and try again.")
 	}
 
-	err = setRealmKeysData(data, keys)
+	if err := setRealmKeysData(data, keys); err != nil {
+		return diag.FromErr(err)
+	}
 
-	return err
+	return nil
 }
 
 func filterKeys(allValues []keycloak.Key, filterAttribute string, allowedValues *schema.Set) []keycloak.Key {
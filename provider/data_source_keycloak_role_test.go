@@ -58,7 +58,7 @@ func testAccCheckDataKeycloakRole(resourceName string) resource.TestCheckFunc {
 		realmId := rs.Primary.Attributes["realm_id"]
 		name := rs.Primary.Attributes["name"]
 
-		role, err := keycloakClient.GetRole(realmId, id)
+		role, err := keycloakClient.GetRole(testCtx, realmId, id)
 		if err != nil {
 			return err
 		}
@@ -1,16 +1,18 @@
 package provider
 
 import (
+	"context"
 	"crypto/sha1"
 	"encoding/base64"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func dataSourceKeycloakSamlClientInstallationProvider() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceKeycloakSamlClientInstallationProviderRead,
+		ReadContext: dataSourceKeycloakSamlClientInstallationProviderRead,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -32,16 +34,16 @@ func dataSourceKeycloakSamlClientInstallationProvider() *schema.Resource {
 	}
 }
 
-func dataSourceKeycloakSamlClientInstallationProviderRead(data *schema.ResourceData, meta interface{}) error {
+func dataSourceKeycloakSamlClientInstallationProviderRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	cliendId := data.Get("client_id").(string)
 	providerId := data.Get("provider_id").(string)
 
-	value, err := keycloakClient.GetSamlClientInstallationProvider(realmId, cliendId, providerId)
+	value, err := keycloakClient.GetSamlClientInstallationProvider(ctx, realmId, cliendId, providerId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	h := sha1.New()
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakSamlIdentityProviderMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakSamlIdentityProviderMetadataRead,
+		Schema: map[string]*schema.Schema{
+			"metadata_xml": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_xml", "metadata_url"},
+				Description:  "The metadata XML of the identity provider.",
+			},
+			"metadata_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "The URL the metadata XML of the identity provider is published at.",
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The entity id of the identity provider. Required if the metadata describes more than one identity provider.",
+			},
+			"single_sign_on_service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"single_logout_service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"post_binding_authn_request": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"post_binding_response": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"post_binding_logout": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"want_authn_requests_signed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"validate_signature": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"signing_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The signing certificates, comma separated as Keycloak expects them.",
+			},
+			"signing_certificates": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_id_formats": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_id_policy_format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first of the NameID formats that can be used as name_id_policy_format of keycloak_saml_identity_provider.",
+			},
+		},
+	}
+}
+
+// Returns the name keycloak_saml_identity_provider uses for the first NameID format it supports
+func getNameIdPolicyFormat(formats []string) string {
+	for _, format := range formats {
+		for name, value := range nameIdPolicyFormats {
+			if value == format {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+func dataSourceKeycloakSamlIdentityProviderMetadataRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	metadataXml := []byte(data.Get("metadata_xml").(string))
+	if metadataUrl := data.Get("metadata_url").(string); metadataUrl != "" {
+		var err error
+		metadataXml, err = keycloakClient.FetchSamlIdentityProviderMetadata(ctx, metadataUrl)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	metadata, err := keycloak.ParseSamlIdentityProviderMetadata(metadataXml, data.Get("entity_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(metadata.EntityId)
+	data.Set("entity_id", metadata.EntityId)
+	data.Set("single_sign_on_service_url", metadata.SingleSignOnServiceUrl)
+	data.Set("single_logout_service_url", metadata.SingleLogoutServiceUrl)
+	data.Set("post_binding_authn_request", metadata.PostBindingResponse)
+	data.Set("post_binding_response", metadata.PostBindingResponse)
+	data.Set("post_binding_logout", metadata.PostBindingLogout)
+	data.Set("want_authn_requests_signed", metadata.WantAuthnRequestsSigned)
+	data.Set("validate_signature", len(metadata.SigningCertificates) != 0)
+	data.Set("signing_certificate", strings.Join(metadata.SigningCertificates, ","))
+	data.Set("signing_certificates", metadata.SigningCertificates)
+	data.Set("name_id_formats", metadata.NameIdFormats)
+	data.Set("name_id_policy_format", getNameIdPolicyFormat(metadata.NameIdFormats))
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testSamlIdentityProviderMetadataXml = `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com">
+  <md:IDPSSODescriptor WantAuthnRequestsSigned="false" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>MIIBcurrent</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>MIIBnext</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/slo"/>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:transient</md:NameIDFormat>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</md:NameIDFormat>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+
+func TestAccKeycloakDataSourceSamlIdentityProviderMetadata_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	dataSourceName := "data.keycloak_saml_identity_provider_metadata.metadata"
+	resourceName := "keycloak_saml_identity_provider.saml"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakSamlIdentityProviderMetadata_basic(alias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceKeycloakSamlIdentityProviderMetadata(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "entity_id", "https://idp.example.com"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "single_sign_on_service_url", resourceName, "single_sign_on_service_url"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "signing_certificate", resourceName, "signing_certificate"),
+					resource.TestCheckResourceAttr(resourceName, "signing_certificate", "MIIBcurrent,MIIBnext"),
+					resource.TestCheckResourceAttr(resourceName, "name_id_policy_format", "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceSamlIdentityProviderMetadata_url(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/samlmetadata+xml")
+		fmt.Fprint(w, testSamlIdentityProviderMetadataXml)
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakSamlIdentityProviderMetadata_url(server.URL + "/metadata"),
+				Check:  testAccCheckDataSourceKeycloakSamlIdentityProviderMetadata("data.keycloak_saml_identity_provider_metadata.metadata"),
+			},
+			{
+				Config:      testDataSourceKeycloakSamlIdentityProviderMetadata_url(server.URL + "/missing"),
+				ExpectError: regexp.MustCompile("error fetching SAML metadata from .+: 404"),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceKeycloakSamlIdentityProviderMetadata(dataSourceName string) resource.TestCheckFunc {
+	expected := map[string]string{
+		"id":                         "https://idp.example.com",
+		"single_sign_on_service_url": "https://idp.example.com/sso",
+		"single_logout_service_url":  "https://idp.example.com/slo",
+		"post_binding_authn_request": "false",
+		"post_binding_logout":        "true",
+		"validate_signature":         "true",
+		"signing_certificate":        "MIIBcurrent,MIIBnext",
+		"signing_certificates.#":     "2",
+		"name_id_formats.#":          "2",
+		"name_id_policy_format":      "Email",
+	}
+
+	var checks []resource.TestCheckFunc
+	for key, value := range expected {
+		checks = append(checks, resource.TestCheckResourceAttr(dataSourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testDataSourceKeycloakSamlIdentityProviderMetadata_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_saml_identity_provider_metadata" "metadata" {
+	metadata_xml = <<-EOT
+%s
+	EOT
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://keycloak.example.com/realms/${data.keycloak_realm.realm.id}"
+	single_sign_on_service_url = data.keycloak_saml_identity_provider_metadata.metadata.single_sign_on_service_url
+	single_logout_service_url  = data.keycloak_saml_identity_provider_metadata.metadata.single_logout_service_url
+	signing_certificate        = data.keycloak_saml_identity_provider_metadata.metadata.signing_certificate
+	validate_signature         = data.keycloak_saml_identity_provider_metadata.metadata.validate_signature
+	post_binding_authn_request = data.keycloak_saml_identity_provider_metadata.metadata.post_binding_authn_request
+	post_binding_response      = data.keycloak_saml_identity_provider_metadata.metadata.post_binding_response
+	post_binding_logout        = data.keycloak_saml_identity_provider_metadata.metadata.post_binding_logout
+	name_id_policy_format      = data.keycloak_saml_identity_provider_metadata.metadata.name_id_policy_format
+}
+	`, testAccRealm.Realm, testSamlIdentityProviderMetadataXml, alias)
+}
+
+func testDataSourceKeycloakSamlIdentityProviderMetadata_url(metadataUrl string) string {
+	return fmt.Sprintf(`
+data "keycloak_saml_identity_provider_metadata" "metadata" {
+	metadata_url = "%s"
+}
+	`, metadataUrl)
+}
@@ -62,7 +62,7 @@ func testAccCheckDataKeycloakUser(resourceName string) resource.TestCheckFunc {
 		realmID := rs.Primary.Attributes["realm_id"]
 		username := rs.Primary.Attributes["username"]
 
-		user, err := keycloakClient.GetUser(realmID, id)
+		user, err := keycloakClient.GetUser(testCtx, realmID, id)
 		if err != nil {
 			return err
 		}
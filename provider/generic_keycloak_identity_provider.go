@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/imdario/mergo"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
@@ -23,9 +25,9 @@ type identityProviderDataSetterFunc func(data *schema.ResourceData, identityProv
 
 func resourceKeycloakIdentityProvider() *schema.Resource {
 	return &schema.Resource{
-		Delete: resourceKeycloakIdentityProviderDelete,
+		DeleteContext: resourceKeycloakIdentityProviderDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakIdentityProviderImport,
+			StateContext: resourceKeycloakIdentityProviderImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"alias": {
@@ -105,32 +107,7 @@ func resourceKeycloakIdentityProvider() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 				// you aren't allowed to specify any keys in extra_config that could be defined as top level attributes
-				ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
-					var diags diag.Diagnostics
-
-					extraConfig := v.(map[string]interface{})
-					value := reflect.ValueOf(&keycloak.IdentityProviderConfig{}).Elem()
-
-					for i := 0; i < value.NumField(); i++ {
-						field := value.Field(i)
-						jsonKey := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
-
-						if jsonKey != "-" && field.CanSet() {
-							if _, ok := extraConfig[jsonKey]; ok {
-								diags = append(diags, diag.Diagnostic{
-									Severity: diag.Error,
-									Summary:  "Invalid extra_config key",
-									Detail:   fmt.Sprintf(`extra_config key "%s" is not allowed, as it conflicts with a top-level schema attribute`, jsonKey),
-									AttributePath: append(path, cty.IndexStep{
-										Key: cty.StringVal(jsonKey),
-									}),
-								})
-							}
-						}
-					}
-
-					return diags
-				},
+				ValidateDiagFunc: validateIdentityProviderExtraConfig(nil),
 			},
 			"gui_order": {
 				Type:        schema.TypeString,
@@ -149,6 +126,42 @@ func resourceKeycloakIdentityProvider() *schema.Resource {
 	}
 }
 
+// Besides the keys of IdentityProviderConfig, extra_config can't contain any of the given keys, which are used by
+// top level attributes of a specific identity provider
+func validateIdentityProviderExtraConfig(configKeys []string) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		extraConfig := v.(map[string]interface{})
+		value := reflect.ValueOf(&keycloak.IdentityProviderConfig{}).Elem()
+
+		conflictingKeys := append([]string{}, configKeys...)
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Field(i)
+			jsonKey := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
+
+			if jsonKey != "-" && field.CanSet() {
+				conflictingKeys = append(conflictingKeys, jsonKey)
+			}
+		}
+
+		for _, key := range conflictingKeys {
+			if _, ok := extraConfig[key]; ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid extra_config key",
+					Detail:   fmt.Sprintf(`extra_config key "%s" is not allowed, as it conflicts with a top-level schema attribute`, key),
+					AttributePath: append(path, cty.IndexStep{
+						Key: cty.StringVal(key),
+					}),
+				})
+			}
+		}
+
+		return diags
+	}
+}
+
 func getIdentityProviderFromData(data *schema.ResourceData) (*keycloak.IdentityProvider, *keycloak.IdentityProviderConfig) {
 	// some identity provider config is shared among all identity providers, so this default config will be used as a base to merge extra config into
 	defaultIdentityProviderConfig := &keycloak.IdentityProviderConfig{
@@ -203,16 +216,20 @@ func setIdentityProviderData(data *schema.ResourceData, identityProvider *keyclo
 	data.Set("sync_mode", identityProvider.Config.SyncMode)
 }
 
-func resourceKeycloakIdentityProviderDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakIdentityProviderDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realm := data.Get("realm").(string)
 	alias := data.Get("alias").(string)
 
-	return keycloakClient.DeleteIdentityProvider(realm, alias)
+	if err := keycloakClient.DeleteIdentityProvider(ctx, realm, alias); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakIdentityProviderImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakIdentityProviderImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 2 {
@@ -226,51 +243,170 @@ func resourceKeycloakIdentityProviderImport(d *schema.ResourceData, _ interface{
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceKeycloakIdentityProviderCreate(getIdentityProviderFromData identityProviderDataGetterFunc, setDataFromIdentityProvider identityProviderDataSetterFunc) func(data *schema.ResourceData, meta interface{}) error {
-	return func(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakIdentityProviderCreate(getIdentityProviderFromData identityProviderDataGetterFunc, setDataFromIdentityProvider identityProviderDataSetterFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
 		identityProvider, err := getIdentityProviderFromData(data)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		if err = keycloakClient.NewIdentityProvider(identityProvider); err != nil {
-			return err
+		if err = keycloakClient.NewIdentityProvider(ctx, identityProvider); err != nil {
+			return diag.FromErr(err)
 		}
 		if err = setDataFromIdentityProvider(data, identityProvider); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		return resourceKeycloakIdentityProviderRead(setDataFromIdentityProvider)(data, meta)
+		return resourceKeycloakIdentityProviderRead(setDataFromIdentityProvider)(ctx, data, meta)
 	}
 }
 
-func resourceKeycloakIdentityProviderRead(setDataFromIdentityProvider identityProviderDataSetterFunc) func(data *schema.ResourceData, meta interface{}) error {
-	return func(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakIdentityProviderRead(setDataFromIdentityProvider identityProviderDataSetterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
 		realm := data.Get("realm").(string)
 		alias := data.Get("alias").(string)
-		identityProvider, err := keycloakClient.GetIdentityProvider(realm, alias)
+		identityProvider, err := keycloakClient.GetIdentityProvider(ctx, realm, alias)
 		if err != nil {
-			return handleNotFoundError(err, data)
+			return handleNotFoundError(ctx, err, data)
 		}
 
-		return setDataFromIdentityProvider(data, identityProvider)
+		if err := setDataFromIdentityProvider(data, identityProvider); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
 	}
 }
 
-func resourceKeycloakIdentityProviderUpdate(getIdentityProviderFromData identityProviderDataGetterFunc, setDataFromIdentityProvider identityProviderDataSetterFunc) func(data *schema.ResourceData, meta interface{}) error {
-	return func(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakIdentityProviderUpdate(getIdentityProviderFromData identityProviderDataGetterFunc, setDataFromIdentityProvider identityProviderDataSetterFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
 		identityProvider, err := getIdentityProviderFromData(data)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = keycloakClient.UpdateIdentityProvider(identityProvider)
+		err = keycloakClient.UpdateIdentityProvider(ctx, identityProvider)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+
+		if err := setDataFromIdentityProvider(data, identityProvider); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+// Social identity providers share their client settings. Their provider specific settings are kept in the config under
+// the given keys, so they are all strings that are left out of the config when empty.
+func resourceKeycloakSocialIdentityProvider(providerId string, socialSchema map[string]*schema.Schema, configKeys map[string]string) *schema.Resource {
+	commonSocialSchema := map[string]*schema.Schema{
+		"alias": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     providerId,
+			Description: "The alias uniquely identifies an identity provider and it is also used to build the redirect uri. Defaults to the provider id.",
+		},
+		"provider_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     providerId,
+			Description: fmt.Sprintf("provider id, is always %s, unless you have a extended custom implementation", providerId),
+		},
+		"client_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Client ID.",
+		},
+		"client_secret": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Client Secret.",
+		},
+		"default_scopes": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The scopes to be sent when asking for authorization. Leave this empty to use the default scopes of the provider.",
+		},
+		"hide_on_login_page": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Hide On Login Page.",
+		},
+	}
+
+	socialResource := resourceKeycloakIdentityProvider()
+	socialResource.Schema = mergeSchemas(socialResource.Schema, mergeSchemas(commonSocialSchema, socialSchema))
+
+	var extraConfigKeys []string
+	for _, key := range configKeys {
+		extraConfigKeys = append(extraConfigKeys, key)
+	}
+	socialResource.Schema["extra_config"].ValidateDiagFunc = validateIdentityProviderExtraConfig(extraConfigKeys)
+
+	getSocialIdentityProviderFromData := func(data *schema.ResourceData) (*keycloak.IdentityProvider, error) {
+		return getSocialIdentityProviderFromData(data, configKeys)
+	}
+	setSocialIdentityProviderData := func(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider) error {
+		return setSocialIdentityProviderData(data, identityProvider, configKeys)
+	}
+
+	socialResource.CreateContext = resourceKeycloakIdentityProviderCreate(getSocialIdentityProviderFromData, setSocialIdentityProviderData)
+	socialResource.ReadContext = resourceKeycloakIdentityProviderRead(setSocialIdentityProviderData)
+	socialResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getSocialIdentityProviderFromData, setSocialIdentityProviderData)
+	return socialResource
+}
+
+func getSocialIdentityProviderFromData(data *schema.ResourceData, configKeys map[string]string) (*keycloak.IdentityProvider, error) {
+	rec, defaultConfig := getIdentityProviderFromData(data)
+	rec.ProviderId = data.Get("provider_id").(string)
+
+	socialIdentityProviderConfig := &keycloak.IdentityProviderConfig{
+		ClientId:        data.Get("client_id").(string),
+		ClientSecret:    data.Get("client_secret").(string),
+		DefaultScope:    data.Get("default_scopes").(string),
+		HideOnLoginPage: keycloak.KeycloakBoolQuoted(data.Get("hide_on_login_page").(bool)),
+	}
+
+	if err := mergo.Merge(socialIdentityProviderConfig, defaultConfig); err != nil {
+		return nil, err
+	}
+
+	for attribute, key := range configKeys {
+		if value := data.Get(attribute).(string); value != "" {
+			socialIdentityProviderConfig.ExtraConfig[key] = value
 		}
+	}
+
+	rec.Config = socialIdentityProviderConfig
 
-		return setDataFromIdentityProvider(data, identityProvider)
+	return rec, nil
+}
+
+func setSocialIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, configKeys map[string]string) error {
+	setIdentityProviderData(data, identityProvider)
+	data.Set("provider_id", identityProvider.ProviderId)
+	data.Set("client_id", identityProvider.Config.ClientId)
+	data.Set("default_scopes", identityProvider.Config.DefaultScope)
+	data.Set("hide_on_login_page", identityProvider.Config.HideOnLoginPage)
+
+	extraConfig := map[string]interface{}{}
+	for key, value := range identityProvider.Config.ExtraConfig {
+		extraConfig[key] = value
+	}
+	for attribute, key := range configKeys {
+		value, _ := extraConfig[key].(string)
+		data.Set(attribute, value)
+		delete(extraConfig, key)
 	}
+	data.Set("extra_config", extraConfig)
+
+	return nil
 }
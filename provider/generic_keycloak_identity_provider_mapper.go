@@ -1,21 +1,25 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
-type identityProviderMapperDataGetterFunc func(data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error)
+type identityProviderMapperDataGetterFunc func(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error)
 type identityProviderMapperDataSetterFunc func(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error
 
 func resourceKeycloakIdentityProviderMapper() *schema.Resource {
 	return &schema.Resource{
-		Delete: resourceKeycloakIdentityProviderMapperDelete,
+		DeleteContext: resourceKeycloakIdentityProviderMapperDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakIdentityProviderMapperImport,
+			StateContext: resourceKeycloakIdentityProviderMapperImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm": {
@@ -44,6 +48,114 @@ func resourceKeycloakIdentityProviderMapper() *schema.Resource {
 	}
 }
 
+// Unlike identity providers, mappers can also inherit the sync mode of the identity provider they belong to
+var identityProviderMapperSyncModes = []string{
+	"INHERIT",
+	"IMPORT",
+	"LEGACY",
+	"FORCE",
+}
+
+// The identity provider ids Keycloak has dedicated OIDC and SAML mappers for. Every other provider is a social one.
+var (
+	oidcIdentityProviderIds = []string{"oidc", "keycloak-oidc"}
+	samlIdentityProviderIds = []string{"saml"}
+)
+
+// Typed mappers manage some of the mapper config through top level attributes. These config keys, along with the sync
+// mode, can't be set through extra_config as well.
+func resourceKeycloakTypedIdentityProviderMapper(mapperSchema map[string]*schema.Schema, configKeys []string, getIdentityProviderMapperFromData identityProviderMapperDataGetterFunc, setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) *schema.Resource {
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.Schema["sync_mode"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "INHERIT",
+		ValidateFunc: validation.StringInSlice(identityProviderMapperSyncModes, false),
+		Description:  "Sync mode of the mapper, which defaults to the sync mode of the identity provider",
+	}
+	genericMapperResource.Schema["extra_config"].ValidateDiagFunc = validateIdentityProviderMapperExtraConfig(append([]string{"syncMode"}, configKeys...))
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getIdentityProviderMapperFromData, setDataFromIdentityProviderMapper)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setDataFromIdentityProviderMapper)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getIdentityProviderMapperFromData, setDataFromIdentityProviderMapper)
+	return genericMapperResource
+}
+
+func validateIdentityProviderMapperExtraConfig(configKeys []string) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		extraConfig := v.(map[string]interface{})
+		for _, key := range configKeys {
+			if _, ok := extraConfig[key]; ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid extra_config key",
+					Detail:   fmt.Sprintf(`extra_config key "%s" is not allowed, as it conflicts with a top-level schema attribute`, key),
+					AttributePath: append(path, cty.IndexStep{
+						Key: cty.StringVal(key),
+					}),
+				})
+			}
+		}
+
+		return diags
+	}
+}
+
+// Fetches the identity provider the mapper belongs to, as the mapper type and its config depend on the provider id
+func getTypedIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, *keycloak.IdentityProvider, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+	rec, _ := getIdentityProviderMapperFromData(data)
+
+	identityProvider, err := keycloakClient.GetIdentityProvider(ctx, rec.Realm, rec.IdentityProviderAlias)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rec.Config = map[string]interface{}{}
+	if v, ok := data.GetOk("extra_config"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			rec.Config[key] = value
+		}
+	}
+	rec.Config["syncMode"] = data.Get("sync_mode").(string)
+
+	return rec, identityProvider, nil
+}
+
+func setTypedIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper, configKeys []string) {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+
+	extraConfig := map[string]interface{}{}
+	for key, value := range identityProviderMapper.Config {
+		if key != "syncMode" && !stringSliceContains(configKeys, key) {
+			extraConfig[key] = value
+		}
+	}
+
+	syncMode, ok := identityProviderMapper.Config["syncMode"].(string)
+	if !ok || syncMode == "" {
+		syncMode = "INHERIT"
+	}
+
+	data.Set("sync_mode", syncMode)
+	data.Set("extra_config", extraConfig)
+}
+
+func checkIdentityProviderMapperSupported(mapperType string, identityProvider *keycloak.IdentityProvider, providerIds []string) error {
+	if !stringSliceContains(providerIds, identityProvider.ProviderId) {
+		return fmt.Errorf("%s identity provider mappers are not supported by identity provider %s, which is of type %s. Supported types are: %s", mapperType, identityProvider.Alias, identityProvider.ProviderId, strings.Join(providerIds, ", "))
+	}
+
+	return nil
+}
+
+func getIdentityProviderMapperConfigString(identityProviderMapper *keycloak.IdentityProviderMapper, key string) string {
+	value, _ := identityProviderMapper.Config[key].(string)
+	return value
+}
+
 func getIdentityProviderMapperFromData(data *schema.ResourceData) (*keycloak.IdentityProviderMapper, error) {
 	rec := &keycloak.IdentityProviderMapper{
 		Id:                    data.Id(),
@@ -62,17 +174,21 @@ func setIdentityProviderMapperData(data *schema.ResourceData, identityProviderMa
 	return nil
 }
 
-func resourceKeycloakIdentityProviderMapperDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakIdentityProviderMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realm := data.Get("realm").(string)
 	alias := data.Get("identity_provider_alias").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteIdentityProviderMapper(realm, alias, id)
+	if err := keycloakClient.DeleteIdentityProviderMapper(ctx, realm, alias, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakIdentityProviderMapperImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakIdentityProviderMapperImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 3 {
@@ -86,49 +202,52 @@ func resourceKeycloakIdentityProviderMapperImport(d *schema.ResourceData, _ inte
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceKeycloakIdentityProviderMapperCreate(getIdentityProviderMapperFromData identityProviderMapperDataGetterFunc, setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) func(data *schema.ResourceData, meta interface{}) error {
-	return func(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakIdentityProviderMapperCreate(getIdentityProviderMapperFromData identityProviderMapperDataGetterFunc, setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
-		identityProvider, err := getIdentityProviderMapperFromData(data, meta)
+		identityProvider, err := getIdentityProviderMapperFromData(ctx, data, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		if err = keycloakClient.NewIdentityProviderMapper(identityProvider); err != nil {
-			return err
+		if err = keycloakClient.NewIdentityProviderMapper(ctx, identityProvider); err != nil {
+			return diag.FromErr(err)
 		}
 		if err = setDataFromIdentityProviderMapper(data, identityProvider); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		return resourceKeycloakIdentityProviderMapperRead(setDataFromIdentityProviderMapper)(data, meta)
+		return resourceKeycloakIdentityProviderMapperRead(setDataFromIdentityProviderMapper)(ctx, data, meta)
 	}
 }
 
-func resourceKeycloakIdentityProviderMapperRead(setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) func(data *schema.ResourceData, meta interface{}) error {
-	return func(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakIdentityProviderMapperRead(setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
 		realm := data.Get("realm").(string)
 		alias := data.Get("identity_provider_alias").(string)
 		id := data.Id()
-		identityProvider, err := keycloakClient.GetIdentityProviderMapper(realm, alias, id)
+		identityProvider, err := keycloakClient.GetIdentityProviderMapper(ctx, realm, alias, id)
 		if err != nil {
-			return handleNotFoundError(err, data)
+			return handleNotFoundError(ctx, err, data)
 		}
 		if err = setDataFromIdentityProviderMapper(data, identityProvider); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		return nil
 	}
 }
 
-func resourceKeycloakIdentityProviderMapperUpdate(getIdentityProviderMapperFromData identityProviderMapperDataGetterFunc, setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) func(data *schema.ResourceData, meta interface{}) error {
-	return func(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakIdentityProviderMapperUpdate(getIdentityProviderMapperFromData identityProviderMapperDataGetterFunc, setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
-		identityProvider, err := getIdentityProviderMapperFromData(data, meta)
-		if err = keycloakClient.UpdateIdentityProviderMapper(identityProvider); err != nil {
-			return err
+		identityProvider, err := getIdentityProviderMapperFromData(ctx, data, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = keycloakClient.UpdateIdentityProviderMapper(ctx, identityProvider); err != nil {
+			return diag.FromErr(err)
 		}
 		if err = setDataFromIdentityProviderMapper(data, identityProvider); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		return nil
 	}
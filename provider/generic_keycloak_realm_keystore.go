@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+var (
+	keycloakRealmKeystoreRsaAlgorithms  = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}
+	keycloakRealmKeystoreHmacAlgorithms = []string{"HS256", "HS384", "HS512"}
+)
+
+// Returns the schema of a realm keystore, which consists of the settings shared by all key providers and the given
+// provider specific ones
+func realmKeystoreSchema(providerSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	return mergeSchemas(map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Display name of the provider when displayed in the console.",
+		},
+		"realm_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The realm this keystore exists in.",
+		},
+		"active": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "When false, the keys are still available to verify signatures, but no longer used to create new ones.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "When false, the keys aren't used at all.",
+		},
+		"priority": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     0,
+			Description: "Priority of the keys. The active key with the highest priority is used to sign new tokens.",
+		},
+	}, providerSchema)
+}
+
+func getRealmKeystoreFromData(data *schema.ResourceData) keycloak.RealmKeystore {
+	return keycloak.RealmKeystore{
+		Id:       data.Id(),
+		Name:     data.Get("name").(string),
+		RealmId:  data.Get("realm_id").(string),
+		Active:   data.Get("active").(bool),
+		Enabled:  data.Get("enabled").(bool),
+		Priority: data.Get("priority").(int),
+	}
+}
+
+func setRealmKeystoreData(data *schema.ResourceData, keystore keycloak.RealmKeystore) {
+	data.SetId(keystore.Id)
+	data.Set("name", keystore.Name)
+	data.Set("realm_id", keystore.RealmId)
+	data.Set("active", keystore.Active)
+	data.Set("enabled", keystore.Enabled)
+	data.Set("priority", keystore.Priority)
+}
+
+func resourceKeycloakRealmKeystoreImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{keystoreId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Returns a resource for a key provider which generates its own keys. configKeys maps the attributes of generatedSchema
+// to the config keys Keycloak stores them as
+func resourceKeycloakRealmKeystoreGenerated(providerId string, generatedSchema map[string]*schema.Schema, configKeys map[string]string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmKeystoreGeneratedCreate(providerId, generatedSchema, configKeys),
+		ReadContext:   resourceKeycloakRealmKeystoreGeneratedRead(providerId, generatedSchema, configKeys),
+		UpdateContext: resourceKeycloakRealmKeystoreGeneratedUpdate(providerId, generatedSchema, configKeys),
+		DeleteContext: resourceKeycloakRealmKeystoreGeneratedDelete,
+		// This resource can be imported using {{realm}}/{{keystore_id}}. The keystore ID is displayed in the URL when editing it from the GUI
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmKeystoreImport,
+		},
+		Schema: realmKeystoreSchema(generatedSchema),
+	}
+}
+
+func getRealmKeystoreGeneratedFromData(data *schema.ResourceData, providerId string, configKeys map[string]string) *keycloak.RealmKeystoreGenerated {
+	config := make(map[string]string, len(configKeys))
+	for attribute, configKey := range configKeys {
+		switch value := data.Get(attribute).(type) {
+		case int:
+			config[configKey] = strconv.Itoa(value)
+		default:
+			config[configKey] = value.(string)
+		}
+	}
+
+	return &keycloak.RealmKeystoreGenerated{
+		RealmKeystore: getRealmKeystoreFromData(data),
+		ProviderId:    providerId,
+		Config:        config,
+	}
+}
+
+func setRealmKeystoreGeneratedData(data *schema.ResourceData, keystore *keycloak.RealmKeystoreGenerated, generatedSchema map[string]*schema.Schema, configKeys map[string]string) error {
+	setRealmKeystoreData(data, keystore.RealmKeystore)
+
+	for attribute, configKey := range configKeys {
+		value, ok := keystore.Config[configKey]
+		// Keycloak falls back to the same defaults as the schema for settings missing from the config
+		if !ok || value == "" {
+			data.Set(attribute, generatedSchema[attribute].Default)
+			continue
+		}
+
+		if generatedSchema[attribute].Type == schema.TypeInt {
+			intValue, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("unable to parse %s of keystore %s: %s", configKey, keystore.Id, err)
+			}
+			data.Set(attribute, intValue)
+		} else {
+			data.Set(attribute, value)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreGeneratedCreate(providerId string, generatedSchema map[string]*schema.Schema, configKeys map[string]string) schema.CreateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		keystore := getRealmKeystoreGeneratedFromData(data, providerId, configKeys)
+
+		err := keycloakClient.NewRealmKeystoreGenerated(ctx, keystore)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		data.SetId(keystore.Id)
+
+		return resourceKeycloakRealmKeystoreGeneratedRead(providerId, generatedSchema, configKeys)(ctx, data, meta)
+	}
+}
+
+func resourceKeycloakRealmKeystoreGeneratedRead(providerId string, generatedSchema map[string]*schema.Schema, configKeys map[string]string) schema.ReadContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		realmId := data.Get("realm_id").(string)
+		id := data.Id()
+
+		keystore, err := keycloakClient.GetRealmKeystoreGenerated(ctx, realmId, id, providerId)
+		if err != nil {
+			return handleNotFoundError(ctx, err, data)
+		}
+
+		if err := setRealmKeystoreGeneratedData(data, keystore, generatedSchema, configKeys); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+func resourceKeycloakRealmKeystoreGeneratedUpdate(providerId string, generatedSchema map[string]*schema.Schema, configKeys map[string]string) schema.UpdateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		keystore := getRealmKeystoreGeneratedFromData(data, providerId, configKeys)
+
+		err := keycloakClient.UpdateRealmKeystoreGenerated(ctx, keystore)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceKeycloakRealmKeystoreGeneratedRead(providerId, generatedSchema, configKeys)(ctx, data, meta)
+	}
+}
+
+func resourceKeycloakRealmKeystoreGeneratedDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	if err := keycloakClient.DeleteRealmKeystoreGenerated(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -1,12 +1,15 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
-func setOpenidClientScopePermissionPolicy(keycloakClient *keycloak.KeycloakClient, realmId, realmManagementClientId, authorizationPermissionId string, scopeDataSet *schema.Set) error {
+func setOpenidClientScopePermissionPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, realmManagementClientId, authorizationPermissionId string, scopeDataSet *schema.Set) error {
 	var policies []string
 
 	scopePermission := scopeDataSet.List()[0].(map[string]interface{})
@@ -17,7 +20,7 @@ func setOpenidClientScopePermissionPolicy(keycloakClient *keycloak.KeycloakClien
 		}
 	}
 
-	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(realmId, realmManagementClientId, authorizationPermissionId)
+	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClientId, authorizationPermissionId)
 	if err != nil {
 		return err
 	}
@@ -32,11 +35,11 @@ func setOpenidClientScopePermissionPolicy(keycloakClient *keycloak.KeycloakClien
 
 	permission.Policies = policies
 
-	return keycloakClient.UpdateOpenidClientAuthorizationPermission(permission)
+	return keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
 }
 
-func getOpenidClientScopePermissionPolicy(keycloakClient *keycloak.KeycloakClient, realmId string, realmManagementClientId, permissionId string) (map[string]interface{}, error) {
-	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(realmId, realmManagementClientId, permissionId)
+func getOpenidClientScopePermissionPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, realmManagementClientId, permissionId string) (map[string]interface{}, error) {
+	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClientId, permissionId)
 	if err != nil {
 		return nil, err
 	}
@@ -87,3 +90,57 @@ func scopePermissionsSchema() *schema.Schema {
 		},
 	}
 }
+
+// Sets the scope permissions of a resource, given the attribute each of its scopes is configured by. The scopes that are
+// removed from the configuration are reset to the permission Keycloak creates, which has no policies.
+func setScopePermissionPolicies(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, realmId, realmManagementClientId string, scopePermissions map[string]interface{}, scopes map[string]string) error {
+	for attribute, scope := range scopes {
+		permissionId, ok := scopePermissions[scope].(string)
+		if !ok {
+			return fmt.Errorf("permissions don't contain a %s scope permission", scope)
+		}
+
+		if scopeDataSet, ok := data.GetOk(attribute); ok {
+			if err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClientId, permissionId, scopeDataSet.(*schema.Set)); err != nil {
+				return err
+			}
+		} else if data.HasChange(attribute) {
+			permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClientId, permissionId)
+			if err != nil {
+				return err
+			}
+
+			permission.Description = ""
+			permission.DecisionStrategy = "UNANIMOUS"
+			permission.Policies = []string{}
+
+			if err := keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func getScopePermissionPolicies(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, realmId, realmManagementClientId string, scopePermissions map[string]interface{}, scopes map[string]string) error {
+	for attribute, scope := range scopes {
+		permissionId, ok := scopePermissions[scope].(string)
+		if !ok {
+			return fmt.Errorf("permissions don't contain a %s scope permission", scope)
+		}
+
+		scopePermission, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClientId, permissionId)
+		if err != nil {
+			return err
+		}
+
+		if scopePermission != nil {
+			data.Set(attribute, []interface{}{scopePermission})
+		} else {
+			data.Set(attribute, nil)
+		}
+	}
+
+	return nil
+}
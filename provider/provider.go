@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
@@ -26,10 +28,21 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_authentication_execution":           dataSourceKeycloakAuthenticationExecution(),
 			"keycloak_authentication_flow":                dataSourceKeycloakAuthenticationFlow(),
 			"keycloak_client_description_converter":       dataSourceKeycloakClientDescriptionConverter(),
+			"keycloak_saml_identity_provider_metadata":    dataSourceKeycloakSamlIdentityProviderMetadata(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"keycloak_realm":                                             resourceKeycloakRealm(),
 			"keycloak_realm_events":                                      resourceKeycloakRealmEvents(),
+			"keycloak_realm_keystore_rsa":                                resourceKeycloakRealmKeystoreRsa(),
+			"keycloak_realm_keystore_rsa_generated":                      resourceKeycloakRealmKeystoreRsaGenerated(),
+			"keycloak_realm_keystore_hmac_generated":                     resourceKeycloakRealmKeystoreHmacGenerated(),
+			"keycloak_realm_keystore_aes_generated":                      resourceKeycloakRealmKeystoreAesGenerated(),
+			"keycloak_realm_keystore_ecdsa_generated":                    resourceKeycloakRealmKeystoreEcdsaGenerated(),
+			"keycloak_realm_keystore_java_keystore":                      resourceKeycloakRealmKeystoreJavaKeystore(),
+			"keycloak_realm_localization":                                resourceKeycloakRealmLocalization(),
+			"keycloak_realm_user_profile":                                resourceKeycloakRealmUserProfile(),
+			"keycloak_realm_client_policy_profile":                       resourceKeycloakRealmClientPolicyProfile(),
+			"keycloak_realm_client_policy":                               resourceKeycloakRealmClientPolicy(),
 			"keycloak_required_action":                                   resourceKeycloakRequiredAction(),
 			"keycloak_group":                                             resourceKeycloakGroup(),
 			"keycloak_group_memberships":                                 resourceKeycloakGroupMemberships(),
@@ -71,8 +84,21 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_saml_user_property_protocol_mapper":                resourceKeycloakSamlUserPropertyProtocolMapper(),
 			"keycloak_saml_script_protocol_mapper":                       resourceKeycloakSamlScriptProtocolMapper(),
 			"keycloak_custom_identity_provider_mapper":                   resourceKeycloakCustomIdentityProviderMapper(),
+			"keycloak_attribute_importer_identity_provider_mapper":       resourceKeycloakAttributeImporterIdentityProviderMapper(),
+			"keycloak_hardcoded_role_identity_provider_mapper":           resourceKeycloakHardcodedRoleIdentityProviderMapper(),
+			"keycloak_hardcoded_attribute_identity_provider_mapper":      resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
+			"keycloak_hardcoded_group_identity_provider_mapper":          resourceKeycloakHardcodedGroupIdentityProviderMapper(),
+			"keycloak_advanced_claim_to_role_identity_provider_mapper":   resourceKeycloakAdvancedClaimToRoleIdentityProviderMapper(),
+			"keycloak_user_template_importer_identity_provider_mapper":   resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
+			"keycloak_saml_attribute_to_role_identity_provider_mapper":   resourceKeycloakSamlAttributeToRoleIdentityProviderMapper(),
 			"keycloak_saml_identity_provider":                            resourceKeycloakSamlIdentityProvider(),
 			"keycloak_oidc_google_identity_provider":                     resourceKeycloakOidcGoogleIdentityProvider(),
+			"keycloak_github_identity_provider":                          resourceKeycloakGithubIdentityProvider(),
+			"keycloak_microsoft_identity_provider":                       resourceKeycloakMicrosoftIdentityProvider(),
+			"keycloak_facebook_identity_provider":                        resourceKeycloakFacebookIdentityProvider(),
+			"keycloak_gitlab_identity_provider":                          resourceKeycloakGitlabIdentityProvider(),
+			"keycloak_apple_identity_provider":                           resourceKeycloakAppleIdentityProvider(),
+			"keycloak_linkedin_identity_provider":                        resourceKeycloakLinkedinIdentityProvider(),
 			"keycloak_oidc_identity_provider":                            resourceKeycloakOidcIdentityProvider(),
 			"keycloak_openid_client_authorization_resource":              resourceKeycloakOpenidClientAuthorizationResource(),
 			"keycloak_openid_client_group_policy":                        resourceKeycloakOpenidClientAuthorizationGroupPolicy(),
@@ -97,11 +123,14 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_identity_provider_token_exchange_scope_permission": resourceKeycloakIdentityProviderTokenExchangeScopePermission(),
 			"keycloak_openid_client_permissions":                         resourceKeycloakOpenidClientPermissions(),
 			"keycloak_users_permissions":                                 resourceKeycloakUsersPermissions(),
+			"keycloak_group_permissions":                                 resourceKeycloakGroupPermissions(),
+			"keycloak_role_permissions":                                  resourceKeycloakRolePermissions(),
+			"keycloak_identity_provider_permissions":                     resourceKeycloakIdentityProviderPermissions(),
 			"keycloak_user_groups":                                       resourceKeycloakUserGroups(),
 		},
 		Schema: map[string]*schema.Schema{
 			"client_id": {
-				Required:    true,
+				Optional:    true,
 				Type:        schema.TypeString,
 				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_CLIENT_ID", nil),
 			},
@@ -110,6 +139,39 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 				Type:        schema.TypeString,
 				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_CLIENT_SECRET", nil),
 			},
+			"client_assertion_private_key": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Description: "A PEM encoded private key, or the path to a file containing one, used to sign a JWT that authenticates the client instead of a client secret",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_CLIENT_ASSERTION_PRIVATE_KEY", ""),
+			},
+			"client_assertion_key_id": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "The key id sent in the `kid` header of the signed JWT",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_CLIENT_ASSERTION_KEY_ID", ""),
+			},
+			"client_assertion_algorithm": {
+				Optional:     true,
+				Type:         schema.TypeString,
+				Description:  "The algorithm used to sign the JWT",
+				Default:      "RS256",
+				ValidateFunc: validation.StringInSlice([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}, false),
+			},
+			"access_token": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Description: "An access token issued elsewhere, which is used instead of logging in to Keycloak",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_ACCESS_TOKEN", ""),
+			},
+			"token_command": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "A shell command that prints an access token, which is run whenever the provider needs a new access token instead of logging in to Keycloak",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_TOKEN_COMMAND", ""),
+			},
 			"username": {
 				Optional:    true,
 				Type:        schema.TypeString,
@@ -146,7 +208,7 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"root_ca_certificate": {
 				Optional:    true,
 				Type:        schema.TypeString,
-				Description: "Allows x509 calls using an unknown CA certificate (for development purposes)",
+				Description: "A PEM encoded CA certificate, or the path to a file containing one, that is trusted in addition to the system's CA certificates",
 				Default:     "",
 			},
 			"tls_insecure_skip_verify": {
@@ -155,10 +217,54 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 				Description: "Allows ignoring insecure certificates when set to true. Defaults to false. Disabling security check is dangerous and should be avoided.",
 				Default:     false,
 			},
+			"tls_client_certificate": {
+				Optional:     true,
+				Type:         schema.TypeString,
+				Description:  "A PEM encoded client certificate, or the path to a file containing one, presented to Keycloak for mutual TLS authentication",
+				Default:      "",
+				RequiredWith: []string{"tls_client_private_key"},
+			},
+			"tls_client_private_key": {
+				Optional:     true,
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				Description:  "The PEM encoded private key for `tls_client_certificate`, or the path to a file containing it",
+				Default:      "",
+				RequiredWith: []string{"tls_client_certificate"},
+			},
+			"tls_min_version": {
+				Optional:     true,
+				Type:         schema.TypeString,
+				Description:  "The minimum TLS version used when connecting to Keycloak. One of `1.0`, `1.1`, `1.2` or `1.3`",
+				Default:      "",
+				ValidateFunc: validation.StringInSlice([]string{"", "1.0", "1.1", "1.2", "1.3"}, false),
+			},
+			"proxy_url": {
+				Optional:     true,
+				Type:         schema.TypeString,
+				Description:  "URL of a proxy used for all requests to Keycloak. When not set, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used",
+				DefaultFunc:  schema.EnvDefaultFunc("KEYCLOAK_PROXY_URL", ""),
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithScheme([]string{"http", "https", "socks5"})),
+			},
+			"max_idle_connections_per_host": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of idle (keep-alive) connections to Keycloak kept open for reuse",
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"idle_connection_timeout": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Time (in seconds) after which an idle (keep-alive) connection to Keycloak is closed",
+				Default:      90,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"base_path": {
-				Optional: true,
-				Type:     schema.TypeString,
-				Default:  "/auth",
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "The base path used for accessing the Keycloak REST API, such as `/auth`. When not set, it is detected on login",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_BASE_PATH", ""),
 			},
 			"additional_headers": {
 				Optional: true,
@@ -167,10 +273,45 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 					Type: schema.TypeString,
 				},
 			},
+			"max_retries": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of times an idempotent request (GET, PUT, DELETE) is retried after a transient error, such as a connection reset or a 502, 503 or 504 response",
+				DefaultFunc:  schema.EnvDefaultFunc("KEYCLOAK_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_min": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Minimum time (in seconds) to wait before retrying a failed request. The wait time doubles with every retry",
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_max": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Maximum time (in seconds) to wait before retrying a failed request, including waits requested by the server via a Retry-After header",
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"requests_per_second": {
+				Optional:     true,
+				Type:         schema.TypeFloat,
+				Description:  "Maximum number of requests per second sent to Keycloak. Defaults to 0, which means unlimited",
+				DefaultFunc:  schema.EnvDefaultFunc("KEYCLOAK_REQUESTS_PER_SECOND", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"max_concurrent_requests": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of requests that are sent to Keycloak at the same time. Defaults to 0, which means unlimited",
+				DefaultFunc:  schema.EnvDefaultFunc("KEYCLOAK_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 	}
 
-	provider.ConfigureContextFunc = func(_ context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	provider.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		if client != nil {
 			return client, nil
 		}
@@ -184,18 +325,48 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 		realm := data.Get("realm").(string)
 		initialLogin := data.Get("initial_login").(bool)
 		clientTimeout := data.Get("client_timeout").(int)
-		tlsInsecureSkipVerify := data.Get("tls_insecure_skip_verify").(bool)
-		rootCaCertificate := data.Get("root_ca_certificate").(string)
+		transportOptions := keycloak.TransportOptions{
+			RootCaCertificate:     data.Get("root_ca_certificate").(string),
+			TlsInsecureSkipVerify: data.Get("tls_insecure_skip_verify").(bool),
+			TlsClientCertificate:  data.Get("tls_client_certificate").(string),
+			TlsClientPrivateKey:   data.Get("tls_client_private_key").(string),
+			TlsMinVersion:         data.Get("tls_min_version").(string),
+			ProxyUrl:              data.Get("proxy_url").(string),
+			MaxIdleConnsPerHost:   data.Get("max_idle_connections_per_host").(int),
+			IdleConnTimeout:       time.Duration(data.Get("idle_connection_timeout").(int)) * time.Second,
+		}
 		additionalHeaders := make(map[string]string)
 		for k, v := range data.Get("additional_headers").(map[string]interface{}) {
 			additionalHeaders[k] = v.(string)
 		}
 
+		retryPolicy := keycloak.RetryPolicy{
+			MaxRetries: data.Get("max_retries").(int),
+			WaitMin:    time.Duration(data.Get("retry_wait_min").(int)) * time.Second,
+			WaitMax:    time.Duration(data.Get("retry_wait_max").(int)) * time.Second,
+		}
+
+		clientAssertion := keycloak.ClientAssertion{
+			PrivateKey: data.Get("client_assertion_private_key").(string),
+			KeyId:      data.Get("client_assertion_key_id").(string),
+			Algorithm:  data.Get("client_assertion_algorithm").(string),
+		}
+
+		externalToken := keycloak.ExternalToken{
+			AccessToken:  data.Get("access_token").(string),
+			TokenCommand: data.Get("token_command").(string),
+		}
+
+		rateLimit := keycloak.RateLimit{
+			RequestsPerSecond:     data.Get("requests_per_second").(float64),
+			MaxConcurrentRequests: data.Get("max_concurrent_requests").(int),
+		}
+
 		var diags diag.Diagnostics
 
 		userAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", provider.TerraformVersion, meta.SDKVersionString())
 
-		keycloakClient, err := keycloak.NewKeycloakClient(url, basePath, clientId, clientSecret, realm, username, password, initialLogin, clientTimeout, rootCaCertificate, tlsInsecureSkipVerify, userAgent, additionalHeaders)
+		keycloakClient, err := keycloak.NewKeycloakClient(ctx, url, basePath, clientId, clientSecret, realm, username, password, initialLogin, clientTimeout, transportOptions, userAgent, additionalHeaders, retryPolicy, rateLimit, clientAssertion, externalToken)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
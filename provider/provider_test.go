@@ -1,15 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak/keycloaktest"
 )
 
 var testAccProviderFactories map[string]func() (*schema.Provider, error)
@@ -18,6 +21,10 @@ var keycloakClient *keycloak.KeycloakClient
 var testAccRealm *keycloak.Realm
 var testAccRealmTwo *keycloak.Realm
 var testAccRealmUserFederation *keycloak.Realm
+var testCtx = context.Background()
+
+// Set when the tests run against the in-memory server from the keycloaktest package, see KEYCLOAK_TEST_OFFLINE
+var testServer *keycloaktest.Server
 
 var requiredEnvironmentVariables = []string{
 	"KEYCLOAK_CLIENT_ID",
@@ -37,6 +44,17 @@ var requiredEnvironmentVariablesDefaultValues = map[string]string{
 func init() {
 	userAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", schema.Provider{}.TerraformVersion, meta.SDKVersionString())
 
+	if os.Getenv("KEYCLOAK_TEST_OFFLINE") != "" {
+		testServer = keycloaktest.NewServer()
+
+		os.Setenv("KEYCLOAK_URL", testServer.URL)
+		os.Setenv("KEYCLOAK_BASE_PATH", testServer.BasePath())
+		os.Setenv("KEYCLOAK_CLIENT_ID", keycloaktest.ClientId)
+		os.Setenv("KEYCLOAK_CLIENT_SECRET", keycloaktest.ClientSecret)
+		os.Setenv("KEYCLOAK_REALM", keycloaktest.Realm)
+		log.Println("running against the in-memory Keycloak at " + testServer.URL)
+	}
+
 	for _, requiredEnvironmentVariable := range requiredEnvironmentVariables {
 		if value := os.Getenv(requiredEnvironmentVariable); value == "" {
 			os.Setenv(requiredEnvironmentVariable, requiredEnvironmentVariablesDefaultValues[requiredEnvironmentVariable])
@@ -46,9 +64,9 @@ func init() {
 
 	os.Setenv("TF_ACC", "1")
 
-	keycloakClient, _ = keycloak.NewKeycloakClient(os.Getenv("KEYCLOAK_URL"), "/auth", os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), "", "", true, 5, "", false, userAgent, map[string]string{
+	keycloakClient, _ = keycloak.NewKeycloakClient(testCtx, os.Getenv("KEYCLOAK_URL"), os.Getenv("KEYCLOAK_BASE_PATH"), os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), "", "", true, 5, keycloak.TransportOptions{Recording: testRecording()}, userAgent, map[string]string{
 		"foo": "bar",
-	})
+	}, keycloak.RetryPolicy{}, keycloak.RateLimit{}, keycloak.ClientAssertion{}, keycloak.ExternalToken{})
 	testAccProvider = KeycloakProvider(keycloakClient)
 	testAccProviderFactories = map[string]func() (*schema.Provider, error){
 		"keycloak": func() (*schema.Provider, error) {
@@ -57,6 +75,33 @@ func init() {
 	}
 }
 
+// Names generated by the tests are random, so they are mapped to the recorded ones when replaying
+var testRandomValues = regexp.MustCompile(`tf-acc(-test)?-\d+`)
+
+// Records the traffic with Keycloak to the cassette in KEYCLOAK_TEST_RECORD, or replays the cassette in
+// KEYCLOAK_TEST_REPLAY without contacting Keycloak. Only names generated with acctest.RandomWithPrefix("tf-acc") are
+// mapped, so tests using acctest.RandString, acctest.RandIntRange or the like fail when replayed, as do tests checking
+// secrets that were redacted from the cassette. Recordings should be made with -parallel 1, so that the requests are
+// replayed in the order they were recorded in, and replayed with the same KEYCLOAK_* variables.
+func testRecording() keycloak.Recording {
+	if cassette := os.Getenv("KEYCLOAK_TEST_RECORD"); cassette != "" {
+		return keycloak.Recording{
+			Mode:     keycloak.RecordingModeRecord,
+			Cassette: cassette,
+		}
+	}
+
+	if cassette := os.Getenv("KEYCLOAK_TEST_REPLAY"); cassette != "" {
+		return keycloak.Recording{
+			Mode:         keycloak.RecordingModeReplay,
+			Cassette:     cassette,
+			RandomValues: testRandomValues,
+		}
+	}
+
+	return keycloak.Recording{}
+}
+
 func TestMain(m *testing.M) {
 	testAccRealm = createTestRealm()
 	testAccRealmTwo = createTestRealm()
@@ -64,21 +109,25 @@ func TestMain(m *testing.M) {
 
 	code := m.Run()
 
-	err := keycloakClient.DeleteRealm(testAccRealm.Realm)
+	err := keycloakClient.DeleteRealm(testCtx, testAccRealm.Realm)
 	if err != nil {
 		os.Exit(1)
 	}
 
-	err = keycloakClient.DeleteRealm(testAccRealmTwo.Realm)
+	err = keycloakClient.DeleteRealm(testCtx, testAccRealmTwo.Realm)
 	if err != nil {
 		os.Exit(1)
 	}
 
-	err = keycloakClient.DeleteRealm(testAccRealmUserFederation.Realm)
+	err = keycloakClient.DeleteRealm(testCtx, testAccRealmUserFederation.Realm)
 	if err != nil {
 		os.Exit(1)
 	}
 
+	if testServer != nil {
+		testServer.Close()
+	}
+
 	os.Exit(code)
 }
 
@@ -90,7 +139,7 @@ func createTestRealm() *keycloak.Realm {
 		Enabled: true,
 	}
 
-	err := keycloakClient.NewRealm(r)
+	err := keycloakClient.NewRealm(testCtx, r)
 	if err != nil {
 		os.Exit(1)
 	}
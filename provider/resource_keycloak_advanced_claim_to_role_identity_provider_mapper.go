@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+var advancedClaimToRoleIdentityProviderMapperConfigKeys = []string{"claims", "are.claim.values.regex", "role"}
+
+// Keycloak stores the claims to match as a JSON encoded list of key/value pairs
+type advancedClaimToRoleClaim struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func resourceKeycloakAdvancedClaimToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"claims": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Required:    true,
+			Description: "The claims the token has to contain for the role to be granted, along with their values",
+		},
+		"claim_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the claim values are regular expressions",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name. Client roles are referenced as {{client_id}}.{{role_name}}",
+		},
+	}
+	return resourceKeycloakTypedIdentityProviderMapper(mapperSchema, advancedClaimToRoleIdentityProviderMapperConfigKeys, getAdvancedClaimToRoleIdentityProviderMapperFromData, setAdvancedClaimToRoleIdentityProviderMapperData)
+}
+
+func getAdvancedClaimToRoleIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, identityProvider, err := getTypedIdentityProviderMapperFromData(ctx, data, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkIdentityProviderMapperSupported("Advanced claim to role", identityProvider, oidcIdentityProviderIds); err != nil {
+		return nil, err
+	}
+
+	var claims []advancedClaimToRoleClaim
+	for key, value := range data.Get("claims").(map[string]interface{}) {
+		claims = append(claims, advancedClaimToRoleClaim{
+			Key:   key,
+			Value: value.(string),
+		})
+	}
+	sort.Slice(claims, func(i, j int) bool {
+		return claims[i].Key < claims[j].Key
+	})
+
+	encodedClaims, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-advanced-role-idp-mapper"
+	rec.Config["claims"] = string(encodedClaims)
+	rec.Config["are.claim.values.regex"] = strconv.FormatBool(data.Get("claim_values_regex").(bool))
+	rec.Config["role"] = data.Get("role").(string)
+
+	return rec, nil
+}
+
+func setAdvancedClaimToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setTypedIdentityProviderMapperData(data, identityProviderMapper, advancedClaimToRoleIdentityProviderMapperConfigKeys)
+
+	claims := map[string]interface{}{}
+	if encodedClaims := getIdentityProviderMapperConfigString(identityProviderMapper, "claims"); encodedClaims != "" {
+		var decodedClaims []advancedClaimToRoleClaim
+		if err := json.Unmarshal([]byte(encodedClaims), &decodedClaims); err != nil {
+			return err
+		}
+		for _, claim := range decodedClaims {
+			claims[claim.Key] = claim.Value
+		}
+	}
+
+	data.Set("claims", claims)
+	data.Set("claim_values_regex", getIdentityProviderMapperConfigString(identityProviderMapper, "are.claim.values.regex") == "true")
+	data.Set("role", getIdentityProviderMapperConfigString(identityProviderMapper, "role"))
+	return nil
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_advanced_claim_to_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, mapperName),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc", "oidc-advanced-role-idp-mapper", "claims", `[{"key":"department","value":"engineering"},{"key":"level","value":"senior"}]`),
+			},
+			{
+				ResourceName:        "keycloak_advanced_claim_to_role_identity_provider_mapper.oidc",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_claimValuesRegex(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_advanced_claim_to_role_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_advanced_claim_to_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderMapper_withIdentityProvider("keycloak-oidc", alias, "keycloak_advanced_claim_to_role_identity_provider_mapper", mapperName, `role               = "offline_access"
+	claim_values_regex = true
+
+	claims = {
+		level      = "senior"
+		department = "eng.*"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, "oidc-advanced-role-idp-mapper", "claims", `[{"key":"department","value":"eng.*"},{"key":"level","value":"senior"}]`),
+					testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, "oidc-advanced-role-idp-mapper", "are.claim.values.regex", "true"),
+					resource.TestCheckResourceAttr(resourceName, "claims.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "claims.department", "eng.*"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_unsupportedIdentityProvider(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_advanced_claim_to_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderMapper_withIdentityProvider("saml", alias, "keycloak_advanced_claim_to_role_identity_provider_mapper", mapperName, `role = "offline_access"
+
+	claims = {
+		level = "senior"
+	}`),
+				ExpectError: regexp.MustCompile("not supported by identity provider .* which is of type saml"),
+			},
+		},
+	})
+}
+
+func testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_advanced_claim_to_role_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = "offline_access"
+
+	claims = {
+		department = "engineering"
+		level      = "senior"
+	}
+}
+	`, testAccRealm.Realm, alias, name)
+}
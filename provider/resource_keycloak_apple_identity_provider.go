@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Keycloak has no built-in Apple provider, this is meant for the community extension that signs the client secret itself
+func resourceKeycloakAppleIdentityProvider() *schema.Resource {
+	appleSchema := map[string]*schema.Schema{
+		"team_id": { //teamId
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The id of the Apple developer team the services id belongs to.",
+		},
+		"key_id": { //keyId
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The id of the private key that is used to sign the client secret.",
+		},
+	}
+
+	appleResource := resourceKeycloakSocialIdentityProvider("apple", appleSchema, map[string]string{
+		"team_id": "teamId",
+		"key_id":  "keyId",
+	})
+	appleResource.Schema["client_secret"].Description = "The contents of the private key (p8 file) that is used to sign the client secret."
+	return appleResource
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+// The config keys of the OIDC, SAML and social attribute importers, which all map onto the same attributes
+var attributeImporterIdentityProviderMapperConfigKeys = []string{
+	"claim",
+	"attribute.name",
+	"attribute.friendly.name",
+	"user.attribute",
+	"jsonField",
+	"userAttribute",
+}
+
+func resourceKeycloakAttributeImporterIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attribute_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Attribute Name",
+			ConflictsWith: []string{"attribute_friendly_name"},
+		},
+		"attribute_friendly_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Attribute Friendly Name",
+			ConflictsWith: []string{"attribute_name"},
+		},
+		"claim_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Claim Name",
+		},
+		"user_attribute": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "User Attribute",
+		},
+	}
+	return resourceKeycloakTypedIdentityProviderMapper(mapperSchema, attributeImporterIdentityProviderMapperConfigKeys, getAttributeImporterIdentityProviderMapperFromData, setAttributeImporterIdentityProviderMapperData)
+}
+
+func getAttributeImporterIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, identityProvider, err := getTypedIdentityProviderMapperFromData(ctx, data, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	attributeName := data.Get("attribute_name").(string)
+	attributeFriendlyName := data.Get("attribute_friendly_name").(string)
+	claimName := data.Get("claim_name").(string)
+	userAttribute := data.Get("user_attribute").(string)
+
+	if stringSliceContains(samlIdentityProviderIds, identityProvider.ProviderId) {
+		if attributeName == "" && attributeFriendlyName == "" {
+			return nil, fmt.Errorf("one of attribute_name or attribute_friendly_name is required for identity provider %s, which is of type %s", identityProvider.Alias, identityProvider.ProviderId)
+		}
+		rec.IdentityProviderMapper = "saml-user-attribute-idp-mapper"
+		rec.Config["attribute.name"] = attributeName
+		rec.Config["attribute.friendly.name"] = attributeFriendlyName
+		rec.Config["user.attribute"] = userAttribute
+		return rec, nil
+	}
+
+	if claimName == "" {
+		return nil, fmt.Errorf("claim_name is required for identity provider %s, which is of type %s", identityProvider.Alias, identityProvider.ProviderId)
+	}
+	if stringSliceContains(oidcIdentityProviderIds, identityProvider.ProviderId) {
+		rec.IdentityProviderMapper = "oidc-user-attribute-idp-mapper"
+		rec.Config["claim"] = claimName
+		rec.Config["user.attribute"] = userAttribute
+	} else {
+		// social identity providers map a field of the user profile JSON instead of a claim
+		rec.IdentityProviderMapper = fmt.Sprintf("%s-user-attribute-mapper", identityProvider.ProviderId)
+		rec.Config["jsonField"] = claimName
+		rec.Config["userAttribute"] = userAttribute
+	}
+
+	return rec, nil
+}
+
+func setAttributeImporterIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setTypedIdentityProviderMapperData(data, identityProviderMapper, attributeImporterIdentityProviderMapperConfigKeys)
+
+	switch identityProviderMapper.IdentityProviderMapper {
+	case "saml-user-attribute-idp-mapper":
+		data.Set("attribute_name", getIdentityProviderMapperConfigString(identityProviderMapper, "attribute.name"))
+		data.Set("attribute_friendly_name", getIdentityProviderMapperConfigString(identityProviderMapper, "attribute.friendly.name"))
+		data.Set("user_attribute", getIdentityProviderMapperConfigString(identityProviderMapper, "user.attribute"))
+	case "oidc-user-attribute-idp-mapper":
+		data.Set("claim_name", getIdentityProviderMapperConfigString(identityProviderMapper, "claim"))
+		data.Set("user_attribute", getIdentityProviderMapperConfigString(identityProviderMapper, "user.attribute"))
+	default:
+		data.Set("claim_name", getIdentityProviderMapperConfigString(identityProviderMapper, "jsonField"))
+		data.Set("user_attribute", getIdentityProviderMapperConfigString(identityProviderMapper, "userAttribute"))
+	}
+
+	return nil
+}
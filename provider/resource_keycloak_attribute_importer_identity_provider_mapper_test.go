@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakAttributeImporterIdentityProviderMapper_oidc(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_attribute_importer_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAttributeImporterIdentityProviderMapper_oidc(alias, mapperName, "email", "INHERIT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_attribute_importer_identity_provider_mapper.oidc", "oidc-user-attribute-idp-mapper", "claim", "email"),
+					testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_attribute_importer_identity_provider_mapper.oidc", "oidc-user-attribute-idp-mapper", "syncMode", "INHERIT"),
+				),
+			},
+			{
+				Config: testKeycloakAttributeImporterIdentityProviderMapper_oidc(alias, mapperName, "mail", "FORCE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_attribute_importer_identity_provider_mapper.oidc", "oidc-user-attribute-idp-mapper", "claim", "mail"),
+					testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_attribute_importer_identity_provider_mapper.oidc", "oidc-user-attribute-idp-mapper", "syncMode", "FORCE"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_attribute_importer_identity_provider_mapper.oidc",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAttributeImporterIdentityProviderMapper_saml(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_attribute_importer_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAttributeImporterIdentityProviderMapper_saml(alias, mapperName),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_attribute_importer_identity_provider_mapper.saml", "saml-user-attribute-idp-mapper", "attribute.name", "urn:oid:0.9.2342.19200300.100.1.3"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAttributeImporterIdentityProviderMapper_syncModeInExtraConfig(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_attribute_importer_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAttributeImporterIdentityProviderMapper_syncModeInExtraConfig(alias, mapperName),
+				ExpectError: regexp.MustCompile(`extra_config key "syncMode" is not allowed`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAttributeImporterIdentityProviderMapper_providerIds(t *testing.T) {
+	t.Parallel()
+
+	for providerId, expectedMapperType := range map[string]string{
+		"oidc":   "oidc-user-attribute-idp-mapper",
+		"saml":   "saml-user-attribute-idp-mapper",
+		"github": "github-user-attribute-mapper",
+	} {
+		providerId, expectedMapperType := providerId, expectedMapperType
+		t.Run(providerId, func(t *testing.T) {
+			t.Parallel()
+			alias := acctest.RandomWithPrefix("tf-acc")
+			mapperName := acctest.RandomWithPrefix("tf-acc")
+			resourceName := "keycloak_attribute_importer_identity_provider_mapper.mapper"
+
+			attributes := func(syncMode string) string {
+				return fmt.Sprintf(`attribute_name = "email"
+	claim_name     = "email"
+	user_attribute = "email"
+	sync_mode      = "%s"
+
+	extra_config = {
+		foo = "bar"
+	}`, syncMode)
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_attribute_importer_identity_provider_mapper"),
+				Steps: []resource.TestStep{
+					{
+						Config: testKeycloakIdentityProviderMapper_withIdentityProvider(providerId, alias, "keycloak_attribute_importer_identity_provider_mapper", mapperName, attributes("INHERIT")),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, expectedMapperType, "syncMode", "INHERIT"),
+							testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, expectedMapperType, "foo", "bar"),
+							// the config managed by top level attributes shouldn't end up in extra_config
+							resource.TestCheckResourceAttr(resourceName, "extra_config.%", "1"),
+							resource.TestCheckResourceAttr(resourceName, "user_attribute", "email"),
+						),
+					},
+					{
+						Config: testKeycloakIdentityProviderMapper_withIdentityProvider(providerId, alias, "keycloak_attribute_importer_identity_provider_mapper", mapperName, attributes("FORCE")),
+						Check:  testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, expectedMapperType, "syncMode", "FORCE"),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccKeycloakAttributeImporterIdentityProviderMapper_missingClaimName(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_attribute_importer_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderMapper_withIdentityProvider("oidc", alias, "keycloak_attribute_importer_identity_provider_mapper", mapperName, `attribute_name = "email"
+	user_attribute = "email"`),
+				ExpectError: regexp.MustCompile("claim_name is required"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, mapperType, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mapper, err := getKeycloakCustomIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if mapper.IdentityProviderMapper != mapperType {
+			return fmt.Errorf("expected identity provider mapper %s to be of type %s, got %s", mapper.Id, mapperType, mapper.IdentityProviderMapper)
+		}
+		if mapper.Config[key] != value {
+			return fmt.Errorf("expected config %s of identity provider mapper %s to be %s, got %v", key, mapper.Id, value, mapper.Config[key])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakIdentityProviderMapperDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("identity provider mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAttributeImporterIdentityProviderMapper_oidc(alias, name, claimName, syncMode string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_attribute_importer_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	claim_name              = "%s"
+	user_attribute          = "email"
+	sync_mode               = "%s"
+}
+	`, testAccRealm.Realm, alias, name, claimName, syncMode)
+}
+
+func testKeycloakAttributeImporterIdentityProviderMapper_saml(alias, name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_attribute_importer_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	attribute_name          = "urn:oid:0.9.2342.19200300.100.1.3"
+	user_attribute          = "email"
+}
+	`, testAccRealm.Realm, alias, name)
+}
+
+func testKeycloakAttributeImporterIdentityProviderMapper_syncModeInExtraConfig(alias, name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_attribute_importer_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	claim_name              = "email"
+	user_attribute          = "email"
+
+	extra_config = {
+		syncMode = "FORCE"
+	}
+}
+	`, testAccRealm.Realm, alias, name)
+}
+
+// Returns the config of an identity provider with the given provider id, which is one of oidc, keycloak-oidc, saml or
+// github, along with a mapper of the given resource type for it. The mapper is named "mapper" and has the given
+// attributes.
+func testKeycloakIdentityProviderMapper_withIdentityProvider(providerId, alias, mapperResourceType, mapperName, attributes string) string {
+	identityProviderResourceType := fmt.Sprintf("keycloak_%s_identity_provider", providerId)
+	identityProviderAttributes := `client_id     = "example_id"
+	client_secret = "example_token"`
+
+	switch providerId {
+	case "oidc", "keycloak-oidc":
+		identityProviderResourceType = "keycloak_oidc_identity_provider"
+		identityProviderAttributes = fmt.Sprintf(`provider_id       = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"`, providerId)
+	case "saml":
+		identityProviderAttributes = `entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"`
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "%s" "idp" {
+	realm = data.keycloak_realm.realm.id
+	alias = "%s"
+
+	%s
+}
+
+resource "%s" "mapper" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = %s.idp.alias
+
+	%s
+}
+	`, testAccRealm.Realm, identityProviderResourceType, alias, identityProviderAttributes, mapperResourceType, mapperName, identityProviderResourceType, attributes)
+}
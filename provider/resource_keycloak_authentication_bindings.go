@@ -1,18 +1,21 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakAuthenticationBindings() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakAuthenticationBindingsCreate,
-		Read:   resourceKeycloakAuthenticationBindingsRead,
-		Delete: resourceKeycloakAuthenticationBindingsDelete,
-		Update: resourceKeycloakAuthenticationBindingsUpdate,
+		CreateContext: resourceKeycloakAuthenticationBindingsCreate,
+		ReadContext:   resourceKeycloakAuthenticationBindingsRead,
+		DeleteContext: resourceKeycloakAuthenticationBindingsDelete,
+		UpdateContext: resourceKeycloakAuthenticationBindingsUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakAuthenticationBindingsImport,
+			StateContext: resourceKeycloakAuthenticationBindingsImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -54,30 +57,30 @@ func resourceKeycloakAuthenticationBindings() *schema.Resource {
 	}
 }
 
-func resourceKeycloakAuthenticationBindingsCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationBindingsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	json, err := getAuthenticationBindingsFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.UpdateAuthenticationBindings(json)
+	err = keycloakClient.UpdateAuthenticationBindings(ctx, json)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setAuthenticationBindings(data, json)
 
-	return resourceKeycloakAuthenticationBindingsRead(data, meta)
+	return resourceKeycloakAuthenticationBindingsRead(ctx, data, meta)
 }
 
-func resourceKeycloakAuthenticationBindingsRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationBindingsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	result, err := keycloakClient.GetAuthenticationBindings(data.Id())
+	result, err := keycloakClient.GetAuthenticationBindings(ctx, data.Id())
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setAuthenticationBindings(data, result)
@@ -85,17 +88,17 @@ func resourceKeycloakAuthenticationBindingsRead(data *schema.ResourceData, meta
 	return nil
 }
 
-func resourceKeycloakAuthenticationBindingsUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationBindingsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	json, err := getAuthenticationBindingsFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.UpdateAuthenticationBindings(json)
+	err = keycloakClient.UpdateAuthenticationBindings(ctx, json)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setAuthenticationBindings(data, json)
@@ -103,11 +106,11 @@ func resourceKeycloakAuthenticationBindingsUpdate(data *schema.ResourceData, met
 	return nil
 }
 
-func resourceKeycloakAuthenticationBindingsDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationBindingsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	json, err := getAuthenticationBindingsFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	json.BrowserFlowAlias = "browser"
@@ -117,9 +120,9 @@ func resourceKeycloakAuthenticationBindingsDelete(data *schema.ResourceData, met
 	json.ClientAuthenticationFlowAlias = "clients"
 	json.DockerAuthenticationFlowAlias = "docker auth"
 
-	err = keycloakClient.UpdateAuthenticationBindings(json)
+	err = keycloakClient.UpdateAuthenticationBindings(ctx, json)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -147,7 +150,7 @@ func setAuthenticationBindings(data *schema.ResourceData, json *keycloak.Authent
 	data.Set("docker_authentication_flow_alias", json.DockerAuthenticationFlowAlias)
 }
 
-func resourceKeycloakAuthenticationBindingsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakAuthenticationBindingsImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	d.Set("realm_id", d.Id())
 	return []*schema.ResourceData{d}, nil
 }
@@ -71,7 +71,7 @@ func testAccCheckKeycloakAuthenticationBindings(resourceName, flowAlias string)
 			return fmt.Errorf("resource %s not found in state", resourceName)
 		}
 
-		bindings, err := keycloakClient.GetAuthenticationBindings(rs.Primary.Attributes["realm_id"])
+		bindings, err := keycloakClient.GetAuthenticationBindings(testCtx, rs.Primary.Attributes["realm_id"])
 		if err != nil {
 			return fmt.Errorf("error fetching authentication execution config: %v", err)
 		}
@@ -94,7 +94,7 @@ func testAccCheckKeycloakAuthenticationBindingsDestroy(s *terraform.State) error
 			continue
 		}
 
-		if json, err := keycloakClient.GetAuthenticationBindings(rs.Primary.Attributes["realm_id"]); err == nil {
+		if json, err := keycloakClient.GetAuthenticationBindings(testCtx, rs.Primary.Attributes["realm_id"]); err == nil {
 			if json.BrowserFlowAlias != "browser" {
 				return fmt.Errorf("authentication bindings were not cleaned up")
 			}
@@ -1,21 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakAuthenticationExecutionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakAuthenticationExecutionConfigCreate,
-		Read:   resourceKeycloakAuthenticationExecutionConfigRead,
-		Delete: resourceKeycloakAuthenticationExecutionConfigDelete,
-		Update: resourceKeycloakAuthenticationExecutionConfigUpdate,
+		CreateContext: resourceKeycloakAuthenticationExecutionConfigCreate,
+		ReadContext:   resourceKeycloakAuthenticationExecutionConfigRead,
+		DeleteContext: resourceKeycloakAuthenticationExecutionConfigDelete,
+		UpdateContext: resourceKeycloakAuthenticationExecutionConfigUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakAuthenticationExecutionConfigImport,
+			StateContext: resourceKeycloakAuthenticationExecutionConfigImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -64,22 +66,22 @@ func setAuthenticationExecutionConfigData(data *schema.ResourceData, config *key
 	data.Set("config", config.Config)
 }
 
-func resourceKeycloakAuthenticationExecutionConfigCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationExecutionConfigCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	config := getAuthenticationExecutionConfigFromData(data)
 
-	id, err := keycloakClient.NewAuthenticationExecutionConfig(config)
+	id, err := keycloakClient.NewAuthenticationExecutionConfig(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	data.SetId(id)
 
-	return resourceKeycloakAuthenticationExecutionConfigRead(data, meta)
+	return resourceKeycloakAuthenticationExecutionConfigRead(ctx, data, meta)
 }
 
-func resourceKeycloakAuthenticationExecutionConfigRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationExecutionConfigRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	config := &keycloak.AuthenticationExecutionConfig{
@@ -88,9 +90,9 @@ func resourceKeycloakAuthenticationExecutionConfigRead(data *schema.ResourceData
 		Id:          data.Id(),
 	}
 
-	err := keycloakClient.GetAuthenticationExecutionConfig(config)
+	err := keycloakClient.GetAuthenticationExecutionConfig(ctx, config)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setAuthenticationExecutionConfigData(data, config)
@@ -98,20 +100,20 @@ func resourceKeycloakAuthenticationExecutionConfigRead(data *schema.ResourceData
 	return nil
 }
 
-func resourceKeycloakAuthenticationExecutionConfigUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationExecutionConfigUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	config := getAuthenticationExecutionConfigFromData(data)
 
-	err := keycloakClient.UpdateAuthenticationExecutionConfig(config)
+	err := keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceKeycloakAuthenticationExecutionConfigRead(data, meta)
+	return resourceKeycloakAuthenticationExecutionConfigRead(ctx, data, meta)
 }
 
-func resourceKeycloakAuthenticationExecutionConfigDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationExecutionConfigDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	config := &keycloak.AuthenticationExecutionConfig{
@@ -119,10 +121,14 @@ func resourceKeycloakAuthenticationExecutionConfigDelete(data *schema.ResourceDa
 		Id:      data.Id(),
 	}
 
-	return keycloakClient.DeleteAuthenticationExecutionConfig(config)
+	if err := keycloakClient.DeleteAuthenticationExecutionConfig(ctx, config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakAuthenticationExecutionConfigImport(data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakAuthenticationExecutionConfigImport(ctx context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(data.Id(), "/")
 
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
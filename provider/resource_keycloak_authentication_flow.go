@@ -1,11 +1,13 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
@@ -13,12 +15,12 @@ import (
 
 func resourceKeycloakAuthenticationFlow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakAuthenticationFlowCreate,
-		Read:   resourceKeycloakAuthenticationFlowRead,
-		Delete: resourceKeycloakAuthenticationFlowDelete,
-		Update: resourceKeycloakAuthenticationFlowUpdate,
+		CreateContext: resourceKeycloakAuthenticationFlowCreate,
+		ReadContext:   resourceKeycloakAuthenticationFlowRead,
+		DeleteContext: resourceKeycloakAuthenticationFlowDelete,
+		UpdateContext: resourceKeycloakAuthenticationFlowUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakAuthenticationFlowImport,
+			StateContext: resourceKeycloakAuthenticationFlowImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -77,94 +79,88 @@ func mapFromAuthenticationFlowInfoToData(data *schema.ResourceData, authenticati
 	data.Set("alias", authenticationFlow.Alias)
 }
 
-func resourceKeycloakAuthenticationFlowCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationFlowCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	authenticationFlow := mapFromDataToAuthenticationFlow(data)
 
-	err := keycloakClient.NewAuthenticationFlow(authenticationFlow)
+	err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
 
 	if err != nil {
-		var ae *keycloak.ApiError
-		if !errors.As(err, &ae) {
-			return err
-		}
-
-		if ae.Code != 409 {
-			return err
+		if !keycloak.ErrorIs409(err) {
+			return diag.FromErr(err)
 		}
 
 		log.Println("flow already exists, may be hardcoded flow, try to update")
-		flow, err := keycloakClient.GetAuthenticationFlowFromAlias(authenticationFlow.RealmId, authenticationFlow.Alias)
+		flow, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, authenticationFlow.RealmId, authenticationFlow.Alias)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		data.SetId(flow.Id)
 		authenticationFlow.Id = flow.Id
-		err = resourceKeycloakAuthenticationFlowUpdate(data, meta)
-		if err != nil {
-			return err
+		if diags := resourceKeycloakAuthenticationFlowUpdate(ctx, data, meta); diags.HasError() {
+			return diags
 		}
-		err = keycloakClient.DeleteBuiltInFlowExecutors(authenticationFlow)
+		err = keycloakClient.DeleteBuiltInFlowExecutors(ctx, authenticationFlow)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	mapFromAuthenticationFlowToData(data, authenticationFlow)
-	return resourceKeycloakAuthenticationFlowRead(data, meta)
+	return resourceKeycloakAuthenticationFlowRead(ctx, data, meta)
 }
 
-func resourceKeycloakAuthenticationFlowRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationFlowRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	authenticationFlow, err := keycloakClient.GetAuthenticationFlow(realmId, id)
+	authenticationFlow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	mapFromAuthenticationFlowToData(data, authenticationFlow)
 	return nil
 }
 
-func resourceKeycloakAuthenticationFlowUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationFlowUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	authenticationFlow := mapFromDataToAuthenticationFlow(data)
 
-	err := keycloakClient.UpdateAuthenticationFlow(authenticationFlow)
+	err := keycloakClient.UpdateAuthenticationFlow(ctx, authenticationFlow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mapFromAuthenticationFlowToData(data, authenticationFlow)
 	return nil
 }
 
-func resourceKeycloakAuthenticationFlowDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationFlowDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	err := keycloakClient.DeleteAuthenticationFlow(realmId, id)
+	err := keycloakClient.DeleteAuthenticationFlow(ctx, realmId, id)
 
 	if err != nil {
 		var ae *keycloak.ApiError
 		if !errors.As(err, &ae) {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if ae.Code != 400 && ae.Code != 500 {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if !strings.Contains(ae.Message, "Can't delete built in flow") &&
 			!strings.Contains(ae.Message, "unknown_error") { // unknown_error is common when the flow is still referenced by a client or realm binding
-			return err
+			return diag.FromErr(err)
 		}
 
 		log.Println("flow cannot be deleted, ignore error (probably built-in flow or still referenced by the realm or a client application)")
@@ -173,7 +169,7 @@ func resourceKeycloakAuthenticationFlowDelete(data *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceKeycloakAuthenticationFlowImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakAuthenticationFlowImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 2 {
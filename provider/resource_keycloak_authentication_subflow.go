@@ -1,11 +1,13 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
@@ -13,12 +15,12 @@ import (
 
 func resourceKeycloakAuthenticationSubFlow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakAuthenticationSubFlowCreate,
-		Read:   resourceKeycloakAuthenticationSubFlowRead,
-		Delete: resourceKeycloakAuthenticationSubFlowDelete,
-		Update: resourceKeycloakAuthenticationSubFlowUpdate,
+		CreateContext: resourceKeycloakAuthenticationSubFlowCreate,
+		ReadContext:   resourceKeycloakAuthenticationSubFlowRead,
+		DeleteContext: resourceKeycloakAuthenticationSubFlowDelete,
+		UpdateContext: resourceKeycloakAuthenticationSubFlowUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakAuthenticationSubFlowImport,
+			StateContext: resourceKeycloakAuthenticationSubFlowImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -96,90 +98,84 @@ func mapFromAuthenticationSubFlowToData(data *schema.ResourceData, authenticatio
 	data.Set("built_in", authenticationSubFlow.BuiltIn)
 }
 
-func resourceKeycloakAuthenticationSubFlowCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationSubFlowCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	authenticationFlow := mapFromDataToAuthenticationSubFlow(data)
 
-	err := keycloakClient.NewAuthenticationSubFlow(authenticationFlow)
+	err := keycloakClient.NewAuthenticationSubFlow(ctx, authenticationFlow)
 	if err != nil {
-		var ae *keycloak.ApiError
-		if !errors.As(err, &ae) {
-			return err
-		}
-
-		if ae.Code != 409 {
-			return err
+		if !keycloak.ErrorIs409(err) {
+			return diag.FromErr(err)
 		}
 
 		log.Println("subflow already exists, may be hardcoded flow, try to update")
-		flow, err := keycloakClient.GetAuthenticationSubFlowByAlias(authenticationFlow.RealmId, authenticationFlow.ParentFlowAlias, authenticationFlow.Alias)
+		flow, err := keycloakClient.GetAuthenticationSubFlowByAlias(ctx, authenticationFlow.RealmId, authenticationFlow.ParentFlowAlias, authenticationFlow.Alias)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if flow == nil {
-			return fmt.Errorf("'%v' cannot be used as subflow alias. Please choose another one", authenticationFlow.Alias)
+			return diag.Errorf("'%v' cannot be used as subflow alias. Please choose another one", authenticationFlow.Alias)
 		}
 		data.SetId(flow.Id)
 		authenticationFlow.Id = flow.Id
-		err = resourceKeycloakAuthenticationSubFlowUpdate(data, meta)
-		if err != nil {
-			return err
+		if diags := resourceKeycloakAuthenticationSubFlowUpdate(ctx, data, meta); diags.HasError() {
+			return diags
 		}
 	}
 	mapFromAuthenticationSubFlowToData(data, authenticationFlow)
-	return resourceKeycloakAuthenticationSubFlowRead(data, meta)
+	return resourceKeycloakAuthenticationSubFlowRead(ctx, data, meta)
 }
 
-func resourceKeycloakAuthenticationSubFlowRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationSubFlowRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	parentFlowAlias := data.Get("parent_flow_alias").(string)
 	id := data.Id()
 
-	authenticationFlow, err := keycloakClient.GetAuthenticationSubFlow(realmId, parentFlowAlias, id)
+	authenticationFlow, err := keycloakClient.GetAuthenticationSubFlow(ctx, realmId, parentFlowAlias, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 	mapFromAuthenticationSubFlowToData(data, authenticationFlow)
 	return nil
 }
 
-func resourceKeycloakAuthenticationSubFlowUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationSubFlowUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	authenticationFlow := mapFromDataToAuthenticationSubFlow(data)
 
-	err := keycloakClient.UpdateAuthenticationSubFlow(authenticationFlow)
+	err := keycloakClient.UpdateAuthenticationSubFlow(ctx, authenticationFlow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	mapFromAuthenticationSubFlowToData(data, authenticationFlow)
 	return nil
 }
 
-func resourceKeycloakAuthenticationSubFlowDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakAuthenticationSubFlowDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	parentFlowAlias := data.Get("parent_flow_alias").(string)
 	id := data.Id()
 
-	err := keycloakClient.DeleteAuthenticationSubFlow(realmId, parentFlowAlias, id)
+	err := keycloakClient.DeleteAuthenticationSubFlow(ctx, realmId, parentFlowAlias, id)
 	if err != nil {
 		if err != nil {
 			var ae *keycloak.ApiError
 			if !errors.As(err, &ae) {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if ae.Code != 400 {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if !strings.Contains(ae.Message, "It is illegal to remove execution from a built in flow") {
-				return err
+				return diag.FromErr(err)
 			}
 
 			log.Println("build-in flows cannot be deleted, ignore error")
@@ -188,7 +184,7 @@ func resourceKeycloakAuthenticationSubFlowDelete(data *schema.ResourceData, meta
 	return nil
 }
 
-func resourceKeycloakAuthenticationSubFlowImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakAuthenticationSubFlowImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 3 {
@@ -1,21 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakComponent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakComponentCreate,
-		Read:   resourceKeycloakComponentRead,
-		Update: resourceKeycloakComponentUpdate,
-		Delete: resourceKeycloakComponentDelete,
+		CreateContext: resourceKeycloakComponentCreate,
+		ReadContext:   resourceKeycloakComponentRead,
+		UpdateContext: resourceKeycloakComponentUpdate,
+		DeleteContext: resourceKeycloakComponentDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakComponentImport,
+			StateContext: resourceKeycloakComponentImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -85,31 +87,31 @@ func setComponentData(data *schema.ResourceData, component *keycloak.Component)
 	data.Set("config", config)
 }
 
-func resourceKeycloakComponentCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakComponentCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	component := getComponentFromData(data)
 
-	err := keycloakClient.CreateComponent(realmId, *component)
+	err := keycloakClient.CreateComponent(ctx, realmId, *component)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setComponentData(data, component)
 
-	return resourceKeycloakComponentRead(data, meta)
+	return resourceKeycloakComponentRead(ctx, data, meta)
 }
 
-func resourceKeycloakComponentRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakComponentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 
 	if len(data.Id()) > 0 {
-		component, err := keycloakClient.GetComponent(realmId, data.Id())
+		component, err := keycloakClient.GetComponent(ctx, realmId, data.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		setComponentData(data, component)
@@ -120,9 +122,9 @@ func resourceKeycloakComponentRead(data *schema.ResourceData, meta interface{})
 	providerType := data.Get("provider_type").(string)
 	name := data.Get("name").(string)
 
-	components, err := keycloakClient.GetComponents(realmId, parentId, providerType)
+	components, err := keycloakClient.GetComponents(ctx, realmId, parentId, providerType)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	var comp *keycloak.Component = nil
@@ -134,7 +136,7 @@ func resourceKeycloakComponentRead(data *schema.ResourceData, meta interface{})
 	}
 
 	if comp == nil {
-		return fmt.Errorf("Component could not be found (realm, parent, type, name): %v %v %v %v", realmId, parentId, providerType, name)
+		return diag.Errorf("Component could not be found (realm, parent, type, name): %v %v %v %v", realmId, parentId, providerType, name)
 	}
 
 	setComponentData(data, comp)
@@ -142,15 +144,15 @@ func resourceKeycloakComponentRead(data *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceKeycloakComponentUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakComponentUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	component := getComponentFromData(data)
 
-	err := keycloakClient.UpdateComponent(realmId, *component)
+	err := keycloakClient.UpdateComponent(ctx, realmId, *component)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setComponentData(data, component)
@@ -158,16 +160,20 @@ func resourceKeycloakComponentUpdate(data *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceKeycloakComponentDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakComponentDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteComponent(realmId, id)
+	if err := keycloakClient.DeleteComponent(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakComponentImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakComponentImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	var realmId, id string
@@ -54,7 +54,7 @@ func testAccCheckKeycloakComponentDestroy() resource.TestCheckFunc {
 			parentId := rs.Primary.Attributes["parent_id"]
 			providerType := rs.Primary.Attributes["provider_type"]
 
-			components, _ := keycloakClient.GetComponents(realm, parentId, providerType)
+			components, _ := keycloakClient.GetComponents(testCtx, realm, parentId, providerType)
 			if components != nil {
 				for _, component := range components {
 					if component.Id == id {
@@ -79,7 +79,7 @@ func getComponentFromState(s *terraform.State, resourceName string) (*keycloak.C
 	parentId := rs.Primary.Attributes["parent_id"]
 	providerType := rs.Primary.Attributes["provider_type"]
 
-	components, err := keycloakClient.GetComponents(realm, parentId, providerType)
+	components, err := keycloakClient.GetComponents(testCtx, realm, parentId, providerType)
 	if err != nil {
 		return nil, fmt.Errorf("error getting component with realm/parentId/providerType: %s %s %s - %v", realm, parentId, providerType, err)
 	}
@@ -74,7 +74,7 @@ func TestAccKeycloakCustomIdentityProviderMapper_createAfterManualDestroy(t *tes
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteIdentityProviderMapper(mapper.Realm, mapper.IdentityProviderAlias, mapper.Id)
+					err := keycloakClient.DeleteIdentityProviderMapper(testCtx, mapper.Realm, mapper.IdentityProviderAlias, mapper.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -108,7 +108,7 @@ func TestAccKeycloakCustomIdentityProviderMapper_withExtraConfig_createAfterManu
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteIdentityProviderMapper(mapper.Realm, mapper.IdentityProviderAlias, mapper.Id)
+					err := keycloakClient.DeleteIdentityProviderMapper(testCtx, mapper.Realm, mapper.IdentityProviderAlias, mapper.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -201,7 +201,7 @@ func testAccCheckKeycloakCustomIdentityProviderMapperDestroy() resource.TestChec
 			alias := rs.Primary.Attributes["identity_provider_alias"]
 			id := rs.Primary.ID
 
-			mapper, _ := keycloakClient.GetIdentityProviderMapper(realm, alias, id)
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
 			if mapper != nil {
 				return fmt.Errorf("oidc config with id %s still exists", id)
 			}
@@ -221,7 +221,7 @@ func getKeycloakCustomIdentityProviderMapperFromState(s *terraform.State, resour
 	alias := rs.Primary.Attributes["identity_provider_alias"]
 	id := rs.Primary.ID
 
-	mapper, err := keycloakClient.GetIdentityProviderMapper(realm, alias, id)
+	mapper, err := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting identity provider mapper config with id %s: %s", id, err)
 	}
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
@@ -11,14 +13,15 @@ import (
 
 func resourceKeycloakCustomUserFederation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakCustomUserFederationCreate,
-		Read:   resourceKeycloakCustomUserFederationRead,
-		Update: resourceKeycloakCustomUserFederationUpdate,
-		Delete: resourceKeycloakCustomUserFederationDelete,
+		CreateContext: resourceKeycloakCustomUserFederationCreate,
+		ReadContext:   resourceKeycloakCustomUserFederationRead,
+		UpdateContext: resourceKeycloakCustomUserFederationUpdate,
+		DeleteContext: resourceKeycloakCustomUserFederationDelete,
 		// This resource can be imported using {{realm}}/{{provider_id}}. The Provider ID is displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakCustomUserFederationImport,
+			StateContext: resourceKeycloakCustomUserFederationImport,
 		},
+		Timeouts: slowResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -132,35 +135,35 @@ func setCustomUserFederationData(data *schema.ResourceData, custom *keycloak.Cus
 	data.Set("config", config)
 }
 
-func resourceKeycloakCustomUserFederationCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakCustomUserFederationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	custom := getCustomUserFederationFromData(data)
 
-	err := keycloakClient.ValidateCustomUserFederation(custom)
+	err := keycloakClient.ValidateCustomUserFederation(ctx, custom)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.NewCustomUserFederation(custom)
+	err = keycloakClient.NewCustomUserFederation(ctx, custom)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setCustomUserFederationData(data, custom)
 
-	return resourceKeycloakCustomUserFederationRead(data, meta)
+	return resourceKeycloakCustomUserFederationRead(ctx, data, meta)
 }
 
-func resourceKeycloakCustomUserFederationRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakCustomUserFederationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	custom, err := keycloakClient.GetCustomUserFederation(realmId, id)
+	custom, err := keycloakClient.GetCustomUserFederation(ctx, realmId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setCustomUserFederationData(data, custom)
@@ -168,19 +171,19 @@ func resourceKeycloakCustomUserFederationRead(data *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceKeycloakCustomUserFederationUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakCustomUserFederationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	custom := getCustomUserFederationFromData(data)
 
-	err := keycloakClient.ValidateCustomUserFederation(custom)
+	err := keycloakClient.ValidateCustomUserFederation(ctx, custom)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.UpdateCustomUserFederation(custom)
+	err = keycloakClient.UpdateCustomUserFederation(ctx, custom)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setCustomUserFederationData(data, custom)
@@ -188,16 +191,20 @@ func resourceKeycloakCustomUserFederationUpdate(data *schema.ResourceData, meta
 	return nil
 }
 
-func resourceKeycloakCustomUserFederationDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakCustomUserFederationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteCustomUserFederation(realmId, id)
+	if err := keycloakClient.DeleteCustomUserFederation(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakCustomUserFederationImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakCustomUserFederationImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 2 {
@@ -165,7 +165,7 @@ func testAccGetGroupsFromDefaultGroup(resourceName string, s *terraform.State) (
 
 	realmId := rs.Primary.Attributes["realm_id"]
 
-	return keycloakClient.GetDefaultGroups(realmId)
+	return keycloakClient.GetDefaultGroups(testCtx, realmId)
 }
 
 func testKeycloakDefaultGroups_basic(realmName, groupName string) string {
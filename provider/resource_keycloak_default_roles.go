@@ -1,18 +1,21 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakDefaultRoles() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakDefaultRolesCreate,
-		Read:   resourceKeycloakDefaultRolesRead,
-		Delete: resourceKeycloakDefaultRolesDelete,
-		Update: resourceKeycloakDefaultRolesUpdate,
+		CreateContext: resourceKeycloakDefaultRolesCreate,
+		ReadContext:   resourceKeycloakDefaultRolesRead,
+		DeleteContext: resourceKeycloakDefaultRolesDelete,
+		UpdateContext: resourceKeycloakDefaultRolesUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakDefaultRolesImport,
+			StateContext: resourceKeycloakDefaultRolesImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -29,30 +32,30 @@ func resourceKeycloakDefaultRoles() *schema.Resource {
 	}
 }
 
-func resourceKeycloakDefaultRolesCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakDefaultRolesCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	json, err := getDefaultRolesFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.UpdateDefaultRoles(json)
+	err = keycloakClient.UpdateDefaultRoles(ctx, json)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setDefaultRoles(data, json)
 
-	return resourceKeycloakDefaultRolesRead(data, meta)
+	return resourceKeycloakDefaultRolesRead(ctx, data, meta)
 }
 
-func resourceKeycloakDefaultRolesRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakDefaultRolesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	result, err := keycloakClient.GetDefaultRoles(data.Id())
+	result, err := keycloakClient.GetDefaultRoles(ctx, data.Id())
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setDefaultRoles(data, result)
@@ -60,17 +63,17 @@ func resourceKeycloakDefaultRolesRead(data *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceKeycloakDefaultRolesUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakDefaultRolesUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	json, err := getDefaultRolesFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.UpdateDefaultRoles(json)
+	err = keycloakClient.UpdateDefaultRoles(ctx, json)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setDefaultRoles(data, json)
@@ -78,18 +81,18 @@ func resourceKeycloakDefaultRolesUpdate(data *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceKeycloakDefaultRolesDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakDefaultRolesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	json, err := getDefaultRolesFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	json.DefaultRoles = []string{}
 
-	err = keycloakClient.UpdateDefaultRoles(json)
+	err = keycloakClient.UpdateDefaultRoles(ctx, json)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -115,7 +118,7 @@ func setDefaultRoles(data *schema.ResourceData, json *keycloak.DefaultRoles) {
 	data.Set("default_roles", json.DefaultRoles)
 }
 
-func resourceKeycloakDefaultRolesImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakDefaultRolesImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	d.Set("realm_id", d.Id())
 	return []*schema.ResourceData{d}, nil
 }
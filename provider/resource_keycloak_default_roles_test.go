@@ -67,7 +67,7 @@ func testAccCheckKeycloakDefaultRoles(resourceName string, roles []string) resou
 			return fmt.Errorf("resource %s not found in state", resourceName)
 		}
 
-		bindings, err := keycloakClient.GetDefaultRoles(rs.Primary.Attributes["realm_id"])
+		bindings, err := keycloakClient.GetDefaultRoles(testCtx, rs.Primary.Attributes["realm_id"])
 		if err != nil {
 			return fmt.Errorf("error fetching authentication execution config: %v", err)
 		}
@@ -87,7 +87,7 @@ func testAccCheckKeycloakDefaultRolesDestroy(s *terraform.State) error {
 			continue
 		}
 
-		if json, err := keycloakClient.GetDefaultRoles(rs.Primary.Attributes["realm_id"]); err == nil {
+		if json, err := keycloakClient.GetDefaultRoles(testCtx, rs.Primary.Attributes["realm_id"]); err == nil {
 			if !reflect.DeepEqual(json.DefaultRoles, []string{}) {
 				return fmt.Errorf("default roles were not cleaned up")
 			}
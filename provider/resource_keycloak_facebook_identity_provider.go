@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakFacebookIdentityProvider() *schema.Resource {
+	facebookSchema := map[string]*schema.Schema{
+		"fetched_fields": { //fetchedFields
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Comma separated profile fields to fetch besides the default ones, such as 'birthday,gender'. They can be imported with attribute importer mappers.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("facebook", facebookSchema, map[string]string{
+		"fetched_fields": "fetchedFields",
+	})
+}
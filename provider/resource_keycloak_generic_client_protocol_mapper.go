@@ -1,9 +1,10 @@
 package provider
 
 import (
-	"errors"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -12,16 +13,16 @@ import (
 
 func resourceKeycloakGenericClientProtocolMapper() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakGenericClientProtocolMapperCreate,
-		Read:   resourceKeycloakGenericClientProtocolMapperRead,
-		Delete: resourceKeycloakGenericClientProtocolMapperDelete,
-		Update: resourceKeycloakGenericClientProtocolMapperUpdate,
+		CreateContext: resourceKeycloakGenericClientProtocolMapperCreate,
+		ReadContext:   resourceKeycloakGenericClientProtocolMapperRead,
+		DeleteContext: resourceKeycloakGenericClientProtocolMapperDelete,
+		UpdateContext: resourceKeycloakGenericClientProtocolMapperUpdate,
 		//  import a mapper tied to a client:
 		// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
 		// or a client scope:
 		// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
 		Importer: &schema.ResourceImporter{
-			State: genericProtocolMapperImport,
+			StateContext: genericProtocolMapperImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -105,49 +106,43 @@ func mapFromGenericClientProtocolMapperToData(data *schema.ResourceData, mapper
 	data.Set("realm_id", mapper.RealmId)
 }
 
-func resourceKeycloakGenericClientProtocolMapperCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakGenericClientProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	genericClientProtocolMapper := mapFromDataToGenericClientProtocolMapper(data)
 
-	err := genericClientProtocolMapper.Validate(keycloakClient)
+	err := genericClientProtocolMapper.Validate(ctx, keycloakClient)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.NewGenericClientProtocolMapper(genericClientProtocolMapper)
+	err = keycloakClient.NewGenericClientProtocolMapper(ctx, genericClientProtocolMapper)
 	if err != nil {
-		var ae *keycloak.ApiError
-		if !errors.As(err, &ae) {
-			return err
-		}
-
-		if ae.Code != 409 {
-			return err
+		if !keycloak.ErrorIs409(err) {
+			return diag.FromErr(err)
 		}
 
 		log.Println("resource already exists, may be hardcoded, try to update")
-		r, err := keycloakClient.GetGenericClientProtocolMapperByName(
+		r, err := keycloakClient.GetGenericClientProtocolMapperByName(ctx,
 			genericClientProtocolMapper.RealmId,
 			genericClientProtocolMapper.ClientId,
 			genericClientProtocolMapper.ClientScopeId,
 			genericClientProtocolMapper.Name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		data.SetId(r.Id)
 		genericClientProtocolMapper.Id = r.Id
-		err = resourceKeycloakGenericClientProtocolMapperUpdate(data, meta)
-		if err != nil {
-			return err
+		if diags := resourceKeycloakGenericClientProtocolMapperUpdate(ctx, data, meta); diags.HasError() {
+			return diags
 		}
 	}
 	mapFromGenericClientProtocolMapperToData(data, genericClientProtocolMapper)
 
-	return resourceKeycloakGenericClientProtocolMapperRead(data, meta)
+	return resourceKeycloakGenericClientProtocolMapperRead(ctx, data, meta)
 }
 
-func resourceKeycloakGenericClientProtocolMapperRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakGenericClientProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -155,9 +150,9 @@ func resourceKeycloakGenericClientProtocolMapperRead(data *schema.ResourceData,
 	clientScopeId := data.Get("client_scope_id").(string)
 	id := data.Id()
 
-	resource, err := keycloakClient.GetGenericClientProtocolMapper(realmId, clientId, clientScopeId, id)
+	resource, err := keycloakClient.GetGenericClientProtocolMapper(ctx, realmId, clientId, clientScopeId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	mapFromGenericClientProtocolMapperToData(data, resource)
@@ -165,15 +160,15 @@ func resourceKeycloakGenericClientProtocolMapperRead(data *schema.ResourceData,
 	return nil
 }
 
-func resourceKeycloakGenericClientProtocolMapperUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakGenericClientProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] updating\n")
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	resource := mapFromDataToGenericClientProtocolMapper(data)
 
-	err := keycloakClient.UpdateGenericClientProtocolMapper(resource)
+	err := keycloakClient.UpdateGenericClientProtocolMapper(ctx, resource)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mapFromGenericClientProtocolMapperToData(data, resource)
@@ -181,7 +176,7 @@ func resourceKeycloakGenericClientProtocolMapperUpdate(data *schema.ResourceData
 	return nil
 }
 
-func resourceKeycloakGenericClientProtocolMapperDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakGenericClientProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -189,5 +184,9 @@ func resourceKeycloakGenericClientProtocolMapperDelete(data *schema.ResourceData
 	clientScopeId := data.Get("client_scope_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteGenericClientProtocolMapper(realmId, clientId, clientScopeId, id)
+	if err := keycloakClient.DeleteGenericClientProtocolMapper(ctx, realmId, clientId, clientScopeId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKeycloakGithubIdentityProvider() *schema.Resource {
+	githubSchema := map[string]*schema.Schema{
+		"base_url": { //baseUrl
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The URL of a GitHub Enterprise Server instance. Leave this empty to use https://github.com.",
+		},
+		"api_url": { //apiUrl
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The API URL of a GitHub Enterprise Server instance, such as https://github.example.com/api/v3. Leave this empty to use https://api.github.com.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("github", githubSchema, map[string]string{
+		"base_url": "baseUrl",
+		"api_url":  "apiUrl",
+	})
+}
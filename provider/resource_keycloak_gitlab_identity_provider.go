@@ -0,0 +1,10 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The GitLab identity provider of Keycloak only supports gitlab.com, so it has no provider specific settings
+func resourceKeycloakGitlabIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider("gitlab", map[string]*schema.Schema{}, map[string]string{})
+}
@@ -1,18 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakGroupMemberships() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakGroupMembershipsCreate,
-		Read:   resourceKeycloakGroupMembershipsRead,
-		Delete: resourceKeycloakGroupMembershipsDelete,
-		Update: resourceKeycloakGroupMembershipsUpdate,
+		CreateContext: resourceKeycloakGroupMembershipsCreate,
+		ReadContext:   resourceKeycloakGroupMembershipsRead,
+		DeleteContext: resourceKeycloakGroupMembershipsDelete,
+		UpdateContext: resourceKeycloakGroupMembershipsUpdate,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -34,37 +36,37 @@ func resourceKeycloakGroupMemberships() *schema.Resource {
 	}
 }
 
-func resourceKeycloakGroupMembershipsCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakGroupMembershipsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	groupId := data.Get("group_id").(string)
 	members := data.Get("members").(*schema.Set).List()
 	realmId := data.Get("realm_id").(string)
 
-	err := keycloakClient.ValidateGroupMembers(members)
+	err := keycloakClient.ValidateGroupMembers(ctx, members)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.AddUsersToGroup(realmId, groupId, members)
+	err = keycloakClient.AddUsersToGroup(ctx, realmId, groupId, members)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	data.SetId(groupMembershipsId(realmId, groupId))
 
-	return resourceKeycloakGroupMembershipsRead(data, meta)
+	return resourceKeycloakGroupMembershipsRead(ctx, data, meta)
 }
 
-func resourceKeycloakGroupMembershipsRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakGroupMembershipsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	groupId := data.Get("group_id").(string)
 
-	usersInGroup, err := keycloakClient.GetGroupMembers(realmId, groupId)
+	usersInGroup, err := keycloakClient.GetGroupMembers(ctx, realmId, groupId)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	var members []string
@@ -78,21 +80,21 @@ func resourceKeycloakGroupMembershipsRead(data *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceKeycloakGroupMembershipsUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakGroupMembershipsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	groupId := data.Get("group_id").(string)
 	tfMembers := data.Get("members").(*schema.Set)
 
-	err := keycloakClient.ValidateGroupMembers(tfMembers.List())
+	err := keycloakClient.ValidateGroupMembers(ctx, tfMembers.List())
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	keycloakMembers, err := keycloakClient.GetGroupMembers(realmId, groupId)
+	keycloakMembers, err := keycloakClient.GetGroupMembers(ctx, realmId, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, keycloakMember := range keycloakMembers {
@@ -102,31 +104,35 @@ func resourceKeycloakGroupMembershipsUpdate(data *schema.ResourceData, meta inte
 			tfMembers.Remove(keycloakMember.Username)
 		} else {
 			// if the user exists in keycloak and not in tf state, they need to be removed from the group
-			err = keycloakClient.RemoveUserFromGroup(keycloakMember, groupId)
+			err = keycloakClient.RemoveUserFromGroup(ctx, keycloakMember, groupId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	// at this point, `tfMembers` should only contain users that exist in tf state but not keycloak. these users need to be added
-	err = keycloakClient.AddUsersToGroup(realmId, groupId, tfMembers.List())
+	err = keycloakClient.AddUsersToGroup(ctx, realmId, groupId, tfMembers.List())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	data.SetId(groupMembershipsId(realmId, groupId))
 
-	return resourceKeycloakGroupMembershipsRead(data, meta)
+	return resourceKeycloakGroupMembershipsRead(ctx, data, meta)
 }
 
-func resourceKeycloakGroupMembershipsDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakGroupMembershipsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	groupId := data.Get("group_id").(string)
 
-	return keycloakClient.RemoveUsersFromGroup(realmId, groupId, data.Get("members").(*schema.Set).List())
+	if err := keycloakClient.RemoveUsersFromGroup(ctx, realmId, groupId, data.Get("members").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
 func groupMembershipsId(realmId, groupId string) string {
@@ -162,14 +162,14 @@ func TestAccKeycloakGroupMemberships_authoritativeAdd(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					groupsWithName, err := keycloakClient.ListGroupsWithName(testAccRealm.Realm, groupName)
+					groupsWithName, err := keycloakClient.ListGroupsWithName(testCtx, testAccRealm.Realm, groupName)
 					if err != nil {
 						t.Fatal(err)
 					}
 
 					userToManuallyRemove := usersInGroup[acctest.RandIntRange(0, len(usersInGroup)-1)]
 
-					err = keycloakClient.RemoveUsersFromGroup(testAccRealm.Realm, groupsWithName[0].Id, []interface{}{userToManuallyRemove})
+					err = keycloakClient.RemoveUsersFromGroup(testCtx, testAccRealm.Realm, groupsWithName[0].Id, []interface{}{userToManuallyRemove})
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -213,12 +213,12 @@ func TestAccKeycloakGroupMemberships_authoritativeRemove(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					groupsWithName, err := keycloakClient.ListGroupsWithName(testAccRealm.Realm, groupName)
+					groupsWithName, err := keycloakClient.ListGroupsWithName(testCtx, testAccRealm.Realm, groupName)
 					if err != nil {
 						t.Fatal(err)
 					}
 
-					err = keycloakClient.AddUsersToGroup(testAccRealm.Realm, groupsWithName[0].Id, []interface{}{userToManuallyAdd})
+					err = keycloakClient.AddUsersToGroup(testCtx, testAccRealm.Realm, groupsWithName[0].Id, []interface{}{userToManuallyAdd})
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -250,12 +250,12 @@ func TestAccKeycloakGroupMemberships_noImportNeeded(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					groupsWithName, err := keycloakClient.ListGroupsWithName(testAccRealm.Realm, groupName)
+					groupsWithName, err := keycloakClient.ListGroupsWithName(testCtx, testAccRealm.Realm, groupName)
 					if err != nil {
 						t.Fatal(err)
 					}
 
-					err = keycloakClient.AddUsersToGroup(testAccRealm.Realm, groupsWithName[0].Id, []interface{}{username})
+					err = keycloakClient.AddUsersToGroup(testCtx, testAccRealm.Realm, groupsWithName[0].Id, []interface{}{username})
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -318,7 +318,7 @@ func TestAccKeycloakGroupMemberships_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteGroup(testAccRealm.Realm, *groupId)
+					err := keycloakClient.DeleteGroup(testCtx, testAccRealm.Realm, *groupId)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -345,7 +345,7 @@ func testAccGetUsersInGroupFromGroupMembershipsState(resourceName string, s *ter
 		groupId = rs.Primary.ID
 	}
 
-	return keycloakClient.GetGroupMembers(realmId, groupId)
+	return keycloakClient.GetGroupMembers(testCtx, realmId, groupId)
 }
 
 func testAccCheckUserBelongsToGroup(resourceName, user string) resource.TestCheckFunc {
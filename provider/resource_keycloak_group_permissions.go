@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+// The scope permissions of a group, keyed by the attribute they are configured by
+var groupPermissionsScopes = map[string]string{
+	"view_scope":              "view",
+	"manage_scope":            "manage",
+	"view_members_scope":      "view-members",
+	"manage_members_scope":    "manage-members",
+	"manage_membership_scope": "manage-membership",
+}
+
+func resourceKeycloakGroupPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakGroupPermissionsCreate,
+		ReadContext:   resourceKeycloakGroupPermissionsRead,
+		DeleteContext: resourceKeycloakGroupPermissionsDelete,
+		UpdateContext: resourceKeycloakGroupPermissionsUpdate,
+		// This resource can be imported using {{realm}}/{{group_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGroupPermissionsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"authorization_resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource server id representing the realm management client on which this permission is managed",
+			},
+			"view_scope":              scopePermissionsSchema(),
+			"manage_scope":            scopePermissionsSchema(),
+			"view_members_scope":      scopePermissionsSchema(),
+			"manage_members_scope":    scopePermissionsSchema(),
+			"manage_membership_scope": scopePermissionsSchema(),
+		},
+	}
+}
+
+func groupPermissionsId(realmId, groupId string) string {
+	return fmt.Sprintf("%s/%s", realmId, groupId)
+}
+
+func resourceKeycloakGroupPermissionsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceKeycloakGroupPermissionsUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakGroupPermissionsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	groupId := data.Get("group_id").(string)
+
+	// the existence of this resource implies that permissions are enabled for this group.
+	err := keycloakClient.EnableGroupPermissions(ctx, realmId, groupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupPermissions, err := keycloakClient.GetGroupPermissions(ctx, realmId, groupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setScopePermissionPolicies(ctx, keycloakClient, data, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions, groupPermissionsScopes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakGroupPermissionsRead(ctx, data, meta)
+}
+
+func resourceKeycloakGroupPermissionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+	realmId := data.Get("realm_id").(string)
+	groupId := data.Get("group_id").(string)
+
+	groupPermissions, err := keycloakClient.GetGroupPermissions(ctx, realmId, groupId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if !groupPermissions.Enabled {
+		log.Printf("[WARN] Removing resource with id %s from state as it no longer enabled", data.Id())
+		data.SetId("")
+		return nil
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(groupPermissionsId(groupPermissions.RealmId, groupPermissions.GroupId))
+	data.Set("realm_id", groupPermissions.RealmId)
+	data.Set("group_id", groupPermissions.GroupId)
+	data.Set("enabled", groupPermissions.Enabled)
+	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+
+	err = getScopePermissionPolicies(ctx, keycloakClient, data, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions, groupPermissionsScopes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakGroupPermissionsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	groupId := data.Get("group_id").(string)
+
+	if err := keycloakClient.DisableGroupPermissions(ctx, realmId, groupId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakGroupPermissionsImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{groupId}}")
+	}
+	d.Set("realm_id", parts[0])
+	d.Set("group_id", parts[1])
+
+	d.SetId(groupPermissionsId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
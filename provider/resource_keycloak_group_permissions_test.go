@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakGroupPermissions_basic(t *testing.T) {
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupPermissions_basic(groupName, username, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakGroupScopePermission("keycloak_group_permissions.group", "manage-members", "CONSENSUS", 1),
+					testAccCheckKeycloakGroupScopePermission("keycloak_group_permissions.group", "view", "UNANIMOUS", 0),
+				),
+			},
+			{
+				ResourceName:      "keycloak_group_permissions.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// removing a scope resets its permission
+				Config: testKeycloakGroupPermissions_basic(groupName, username, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakGroupScopePermission("keycloak_group_permissions.group", "manage-members", "UNANIMOUS", 0),
+					resource.TestCheckNoResourceAttr("keycloak_group_permissions.group", "manage_members_scope.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakGroupScopePermission(resourceName, scope, decisionStrategy string, policies int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		groupId := rs.Primary.Attributes["group_id"]
+
+		permissions, err := keycloakClient.GetGroupPermissions(testCtx, realmId, groupId)
+		if err != nil {
+			return fmt.Errorf("error getting group permissions with realm id %s and group id %s: %s", realmId, groupId, err)
+		}
+		if !permissions.Enabled {
+			return fmt.Errorf("expected group permissions to be enabled")
+		}
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(testCtx, realmId, rs.Primary.Attributes["authorization_resource_server_id"], permissions.ScopePermissions[scope].(string))
+		if err != nil {
+			return err
+		}
+
+		if permission.DecisionStrategy != decisionStrategy || len(permission.Policies) != policies {
+			return fmt.Errorf("expected %s scope permission to have decision strategy %s and %d policies, got %s and %v", scope, decisionStrategy, policies, permission.DecisionStrategy, permission.Policies)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakGroupPermissions_basic(groupName, username string, withManageMembersScope bool) string {
+	manageMembersScope := ""
+	if withManageMembersScope {
+		manageMembersScope = `
+	manage_members_scope {
+		policies          = [
+			keycloak_openid_client_user_policy.helpdesk.id
+		]
+		description       = "regional helpdesk"
+		decision_strategy = "CONSENSUS"
+	}`
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_openid_client" "realm_management" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "realm-management"
+}
+
+resource "keycloak_openid_client_permissions" "realm_management_permission" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = data.keycloak_openid_client.realm_management.id
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user" "helpdesk" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_openid_client_user_policy" "helpdesk" {
+	realm_id           = data.keycloak_realm.realm.id
+	resource_server_id = data.keycloak_openid_client.realm_management.id
+
+	name  = "%s"
+	users = [
+		keycloak_user.helpdesk.id
+	]
+
+	logic             = "POSITIVE"
+	decision_strategy = "UNANIMOUS"
+
+	depends_on = [
+		keycloak_openid_client_permissions.realm_management_permission,
+	]
+}
+
+resource "keycloak_group_permissions" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+%s
+}
+	`, testAccRealm.Realm, groupName, username, username, manageMembersScope)
+}
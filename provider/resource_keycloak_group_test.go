@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
@@ -31,6 +32,50 @@ func TestAccKeycloakGroup_basicGroupNameContainsBackSlash(t *testing.T) {
 	runTestBasicGroup(t, groupName, attributeName, attributeValue)
 }
 
+// Runs the CRUD functions of the resource directly, so this doesn't need terraform, and can run against the in-memory
+// Keycloak when KEYCLOAK_TEST_OFFLINE is set
+func TestKeycloakGroup_crud(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	group := resourceKeycloakGroup()
+
+	data := schema.TestResourceDataRaw(t, group.Schema, map[string]interface{}{
+		"realm_id":   testAccRealm.Realm,
+		"name":       groupName,
+		"attributes": map[string]interface{}{"foo": "bar"},
+	})
+
+	if diags := group.CreateContext(testCtx, data, keycloakClient); diags.HasError() {
+		t.Fatalf("error creating group: %v", diags)
+	}
+	if data.Id() == "" || data.Get("path") != "/"+groupName {
+		t.Fatalf("expected the group to be read after creating it, got id %q and path %q", data.Id(), data.Get("path"))
+	}
+
+	data.Set("name", groupName+"-renamed")
+	if diags := group.UpdateContext(testCtx, data, keycloakClient); diags.HasError() {
+		t.Fatalf("error updating group: %v", diags)
+	}
+
+	updated, err := keycloakClient.GetGroup(testCtx, testAccRealm.Realm, data.Id())
+	if err != nil {
+		t.Fatalf("error getting group: %s", err)
+	}
+	if updated.Name != groupName+"-renamed" || updated.Attributes["foo"][0] != "bar" {
+		t.Errorf("expected the group to be updated, got %+v", updated)
+	}
+
+	if diags := group.DeleteContext(testCtx, data, keycloakClient); diags.HasError() {
+		t.Fatalf("error deleting group: %v", diags)
+	}
+
+	// reading a deleted group removes it from the state
+	if diags := group.ReadContext(testCtx, data, keycloakClient); diags.HasError() || data.Id() != "" {
+		t.Errorf("expected the deleted group to be removed from the state, got id %q and %v", data.Id(), diags)
+	}
+}
+
 func runTestBasicGroup(t *testing.T, groupName, attributeName, attributeValue string) {
 	resource.Test(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
@@ -74,7 +119,7 @@ func TestAccKeycloakGroup_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteGroup(group.RealmId, group.Id)
+					err := keycloakClient.DeleteGroup(testCtx, group.RealmId, group.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -326,7 +371,7 @@ func testAccCheckKeycloakGroupDestroy() resource.TestCheckFunc {
 			id := rs.Primary.ID
 			realm := rs.Primary.Attributes["realm_id"]
 
-			group, _ := keycloakClient.GetGroup(realm, id)
+			group, _ := keycloakClient.GetGroup(testCtx, realm, id)
 			if group != nil {
 				return fmt.Errorf("group with id %s still exists", id)
 			}
@@ -345,7 +390,7 @@ func getGroupFromState(s *terraform.State, resourceName string) (*keycloak.Group
 	id := rs.Primary.ID
 	realm := rs.Primary.Attributes["realm_id"]
 
-	group, err := keycloakClient.GetGroup(realm, id)
+	group, err := keycloakClient.GetGroup(testCtx, realm, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting group with id %s: %s", id, err)
 	}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+var hardcodedAttributeIdentityProviderMapperConfigKeys = []string{"attribute", "attribute.value"}
+
+func resourceKeycloakHardcodedAttributeIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attribute_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Attribute Name",
+		},
+		"attribute_value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Attribute Value",
+		},
+		"user_session": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+			Description: "Whether the attribute is set on the user session rather than the user",
+		},
+	}
+	return resourceKeycloakTypedIdentityProviderMapper(mapperSchema, hardcodedAttributeIdentityProviderMapperConfigKeys, getHardcodedAttributeIdentityProviderMapperFromData, setHardcodedAttributeIdentityProviderMapperData)
+}
+
+func getHardcodedAttributeIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _, err := getTypedIdentityProviderMapperFromData(ctx, data, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Get("user_session").(bool) {
+		rec.IdentityProviderMapper = "hardcoded-user-session-attribute-idp-mapper"
+	} else {
+		rec.IdentityProviderMapper = "hardcoded-attribute-idp-mapper"
+	}
+	rec.Config["attribute"] = data.Get("attribute_name").(string)
+	rec.Config["attribute.value"] = data.Get("attribute_value").(string)
+
+	return rec, nil
+}
+
+func setHardcodedAttributeIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setTypedIdentityProviderMapperData(data, identityProviderMapper, hardcodedAttributeIdentityProviderMapperConfigKeys)
+	data.Set("attribute_name", getIdentityProviderMapperConfigString(identityProviderMapper, "attribute"))
+	data.Set("attribute_value", getIdentityProviderMapperConfigString(identityProviderMapper, "attribute.value"))
+	data.Set("user_session", identityProviderMapper.IdentityProviderMapper == "hardcoded-user-session-attribute-idp-mapper")
+	return nil
+}
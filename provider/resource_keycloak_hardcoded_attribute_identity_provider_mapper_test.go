@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakHardcodedAttributeIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_hardcoded_attribute_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakHardcodedAttributeIdentityProviderMapper_basic(alias, mapperName, false),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_hardcoded_attribute_identity_provider_mapper.oidc", "hardcoded-attribute-idp-mapper", "attribute.value", "bar"),
+			},
+			{
+				Config: testKeycloakHardcodedAttributeIdentityProviderMapper_basic(alias, mapperName, true),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_hardcoded_attribute_identity_provider_mapper.oidc", "hardcoded-user-session-attribute-idp-mapper", "attribute", "foo"),
+			},
+			{
+				ResourceName:        "keycloak_hardcoded_attribute_identity_provider_mapper.oidc",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakHardcodedAttributeIdentityProviderMapper_userSession(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_hardcoded_attribute_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_hardcoded_attribute_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderMapper_withIdentityProvider("saml", alias, "keycloak_hardcoded_attribute_identity_provider_mapper", mapperName, `attribute_name  = "foo"
+	attribute_value = "bar"
+	user_session    = true`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, "hardcoded-user-session-attribute-idp-mapper", "attribute", "foo"),
+					testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, "hardcoded-user-session-attribute-idp-mapper", "attribute.value", "bar"),
+					// user_session is read from the mapper type
+					resource.TestCheckResourceAttr(resourceName, "user_session", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakHardcodedAttributeIdentityProviderMapper_basic(alias, name string, userSession bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_hardcoded_attribute_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	attribute_name          = "foo"
+	attribute_value         = "bar"
+	user_session            = %t
+}
+	`, testAccRealm.Realm, alias, name, userSession)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+var hardcodedGroupIdentityProviderMapperConfigKeys = []string{"group"}
+
+func resourceKeycloakHardcodedGroupIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"group": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Group Path, such as /parent/child",
+		},
+	}
+	return resourceKeycloakTypedIdentityProviderMapper(mapperSchema, hardcodedGroupIdentityProviderMapperConfigKeys, getHardcodedGroupIdentityProviderMapperFromData, setHardcodedGroupIdentityProviderMapperData)
+}
+
+func getHardcodedGroupIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _, err := getTypedIdentityProviderMapperFromData(ctx, data, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-hardcoded-group-idp-mapper"
+	rec.Config["group"] = data.Get("group").(string)
+
+	return rec, nil
+}
+
+func setHardcodedGroupIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setTypedIdentityProviderMapperData(data, identityProviderMapper, hardcodedGroupIdentityProviderMapperConfigKeys)
+	data.Set("group", getIdentityProviderMapperConfigString(identityProviderMapper, "group"))
+	return nil
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakHardcodedGroupIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_hardcoded_group_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakHardcodedGroupIdentityProviderMapper_basic(alias, mapperName, groupName),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_hardcoded_group_identity_provider_mapper.saml", "oidc-hardcoded-group-idp-mapper", "group", "/"+groupName),
+			},
+			{
+				ResourceName:        "keycloak_hardcoded_group_identity_provider_mapper.saml",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+		},
+	})
+}
+
+func testKeycloakHardcodedGroupIdentityProviderMapper_basic(alias, name, groupName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_hardcoded_group_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	group                   = keycloak_group.group.path
+	sync_mode               = "IMPORT"
+}
+	`, testAccRealm.Realm, groupName, alias, name)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+var hardcodedRoleIdentityProviderMapperConfigKeys = []string{"role"}
+
+func resourceKeycloakHardcodedRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name. Client roles are referenced as {{client_id}}.{{role_name}}",
+		},
+	}
+	return resourceKeycloakTypedIdentityProviderMapper(mapperSchema, hardcodedRoleIdentityProviderMapperConfigKeys, getHardcodedRoleIdentityProviderMapperFromData, setHardcodedRoleIdentityProviderMapperData)
+}
+
+// Hardcoded mappers are supported by every identity provider
+func getHardcodedRoleIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _, err := getTypedIdentityProviderMapperFromData(ctx, data, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "hardcoded-role-idp-mapper"
+	rec.Config["role"] = data.Get("role").(string)
+
+	return rec, nil
+}
+
+func setHardcodedRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setTypedIdentityProviderMapperData(data, identityProviderMapper, hardcodedRoleIdentityProviderMapperConfigKeys)
+	data.Set("role", getIdentityProviderMapperConfigString(identityProviderMapper, "role"))
+	return nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakHardcodedRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_hardcoded_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakHardcodedRoleIdentityProviderMapper_basic(alias, mapperName, roleName),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_hardcoded_role_identity_provider_mapper.oidc", "hardcoded-role-idp-mapper", "role", roleName),
+			},
+			{
+				ResourceName:        "keycloak_hardcoded_role_identity_provider_mapper.oidc",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+		},
+	})
+}
+
+// Hardcoded mappers aren't limited to OIDC and SAML identity providers
+func TestAccKeycloakHardcodedRoleIdentityProviderMapper_socialIdentityProvider(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_hardcoded_role_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_hardcoded_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderMapper_withIdentityProvider("github", alias, "keycloak_hardcoded_role_identity_provider_mapper", mapperName, `role = "offline_access"`),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, "hardcoded-role-idp-mapper", "role", "offline_access"),
+			},
+			{
+				Config: testKeycloakIdentityProviderMapper_withIdentityProvider("github", alias, "keycloak_hardcoded_role_identity_provider_mapper", mapperName, `role = "uma_authorization"`),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig(resourceName, "hardcoded-role-idp-mapper", "role", "uma_authorization"),
+			},
+		},
+	})
+}
+
+func testKeycloakHardcodedRoleIdentityProviderMapper_basic(alias, name, roleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_hardcoded_role_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = keycloak_role.role.name
+}
+	`, testAccRealm.Realm, roleName, alias, name)
+}
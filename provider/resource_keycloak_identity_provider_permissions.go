@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+// Identity providers only have a token exchange scope permission, keyed by the attribute it is configured by
+var identityProviderPermissionsScopes = map[string]string{
+	"token_exchange_scope": "token-exchange",
+}
+
+func resourceKeycloakIdentityProviderPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakIdentityProviderPermissionsCreate,
+		ReadContext:   resourceKeycloakIdentityProviderPermissionsRead,
+		DeleteContext: resourceKeycloakIdentityProviderPermissionsDelete,
+		UpdateContext: resourceKeycloakIdentityProviderPermissionsUpdate,
+		// This resource can be imported using {{realmId}}/{{providerAlias}}. The provider alias is displayed in the URL when editing it from the GUI
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakIdentityProviderPermissionsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"authorization_resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource server id representing the realm management client on which this permission is managed",
+			},
+			"token_exchange_scope": scopePermissionsSchema(),
+		},
+	}
+}
+
+func identityProviderPermissionsId(realmId, providerAlias string) string {
+	return fmt.Sprintf("%s/%s", realmId, providerAlias)
+}
+
+func resourceKeycloakIdentityProviderPermissionsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceKeycloakIdentityProviderPermissionsUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakIdentityProviderPermissionsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	providerAlias := data.Get("provider_alias").(string)
+
+	// the existence of this resource implies that permissions are enabled for this identity provider.
+	err := keycloakClient.EnableIdentityProviderPermissions(ctx, realmId, providerAlias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	identityProviderPermissions, err := keycloakClient.GetIdentityProviderPermissions(ctx, realmId, providerAlias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setScopePermissionPolicies(ctx, keycloakClient, data, realmId, realmManagementClient.Id, identityProviderPermissions.ScopePermissions, identityProviderPermissionsScopes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakIdentityProviderPermissionsRead(ctx, data, meta)
+}
+
+func resourceKeycloakIdentityProviderPermissionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+	realmId := data.Get("realm_id").(string)
+	providerAlias := data.Get("provider_alias").(string)
+
+	identityProviderPermissions, err := keycloakClient.GetIdentityProviderPermissions(ctx, realmId, providerAlias)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if !identityProviderPermissions.Enabled {
+		log.Printf("[WARN] Removing resource with id %s from state as it no longer enabled", data.Id())
+		data.SetId("")
+		return nil
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(identityProviderPermissionsId(identityProviderPermissions.RealmId, identityProviderPermissions.ProviderAlias))
+	data.Set("realm_id", identityProviderPermissions.RealmId)
+	data.Set("provider_alias", identityProviderPermissions.ProviderAlias)
+	data.Set("enabled", identityProviderPermissions.Enabled)
+	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+
+	err = getScopePermissionPolicies(ctx, keycloakClient, data, realmId, realmManagementClient.Id, identityProviderPermissions.ScopePermissions, identityProviderPermissionsScopes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakIdentityProviderPermissionsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	providerAlias := data.Get("provider_alias").(string)
+
+	if err := keycloakClient.DisableIdentityProviderPermissions(ctx, realmId, providerAlias); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakIdentityProviderPermissionsImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{providerAlias}}")
+	}
+	d.Set("realm_id", parts[0])
+	d.Set("provider_alias", parts[1])
+
+	d.SetId(identityProviderPermissionsId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
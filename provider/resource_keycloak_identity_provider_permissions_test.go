@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakIdentityProviderPermissions_basic(t *testing.T) {
+	alias := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderPermissions_basic(alias, username),
+				Check:  testAccCheckKeycloakIdentityProviderPermissionsTokenExchangeScope("keycloak_identity_provider_permissions.oidc"),
+			},
+			{
+				ResourceName:      "keycloak_identity_provider_permissions.oidc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakIdentityProviderPermissionsTokenExchangeScope(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		providerAlias := rs.Primary.Attributes["provider_alias"]
+
+		permissions, err := keycloakClient.GetIdentityProviderPermissions(testCtx, realmId, providerAlias)
+		if err != nil {
+			return fmt.Errorf("error getting identity provider permissions with realm id %s and alias %s: %s", realmId, providerAlias, err)
+		}
+
+		permissionId, err := permissions.GetTokenExchangeScopedPermissionId()
+		if err != nil {
+			return err
+		}
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(testCtx, realmId, rs.Primary.Attributes["authorization_resource_server_id"], permissionId)
+		if err != nil {
+			return err
+		}
+
+		if len(permission.Policies) != 1 || permission.Policies[0] != rs.Primary.Attributes["token_exchange_scope.0.policies.0"] {
+			return fmt.Errorf("expected token-exchange scope permission to have policy %s, got %v", rs.Primary.Attributes["token_exchange_scope.0.policies.0"], permission.Policies)
+		}
+		if permission.Description != "token exchange" {
+			return fmt.Errorf("expected token-exchange scope permission to have description \"token exchange\", got %q", permission.Description)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakIdentityProviderPermissions_basic(alias, username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_openid_client" "realm_management" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "realm-management"
+}
+
+resource "keycloak_openid_client_permissions" "realm_management_permission" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = data.keycloak_openid_client.realm_management.id
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_openid_client_user_policy" "user" {
+	realm_id           = data.keycloak_realm.realm.id
+	resource_server_id = data.keycloak_openid_client.realm_management.id
+
+	name  = "%s"
+	users = [
+		keycloak_user.user.id
+	]
+
+	logic             = "POSITIVE"
+	decision_strategy = "UNANIMOUS"
+
+	depends_on = [
+		keycloak_openid_client_permissions.realm_management_permission,
+	]
+}
+
+resource "keycloak_identity_provider_permissions" "oidc" {
+	realm_id       = data.keycloak_realm.realm.id
+	provider_alias = keycloak_oidc_identity_provider.oidc.alias
+
+	token_exchange_scope {
+		policies          = [
+			keycloak_openid_client_user_policy.user.id
+		]
+		description       = "token exchange"
+		decision_strategy = "UNANIMOUS"
+	}
+}
+	`, testAccRealm.Realm, alias, username, username)
+}
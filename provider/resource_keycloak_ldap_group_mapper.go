@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
@@ -16,13 +18,13 @@ var (
 
 func resourceKeycloakLdapGroupMapper() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakLdapGroupMapperCreate,
-		Read:   resourceKeycloakLdapGroupMapperRead,
-		Update: resourceKeycloakLdapGroupMapperUpdate,
-		Delete: resourceKeycloakLdapGroupMapperDelete,
+		CreateContext: resourceKeycloakLdapGroupMapperCreate,
+		ReadContext:   resourceKeycloakLdapGroupMapperRead,
+		UpdateContext: resourceKeycloakLdapGroupMapperUpdate,
+		DeleteContext: resourceKeycloakLdapGroupMapperDelete,
 		// This resource can be imported using {{realm}}/{{provider_id}}/{{mapper_id}}. The Provider and Mapper IDs are displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakLdapGenericMapperImport,
+			StateContext: resourceKeycloakLdapGenericMapperImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -121,7 +123,7 @@ func resourceKeycloakLdapGroupMapper() *schema.Resource {
 	}
 }
 
-func getLdapGroupMapperFromData(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) *keycloak.LdapGroupMapper {
+func getLdapGroupMapperFromData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) *keycloak.LdapGroupMapper {
 	var groupObjectClasses []string
 
 	for _, groupObjectClass := range data.Get("group_object_classes").([]interface{}) {
@@ -163,7 +165,7 @@ func getLdapGroupMapperFromData(keycloakClient *keycloak.KeycloakClient, data *s
 	return mapper
 }
 
-func setLdapGroupMapperData(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, ldapGroupMapper *keycloak.LdapGroupMapper) {
+func setLdapGroupMapperData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, ldapGroupMapper *keycloak.LdapGroupMapper) {
 	data.SetId(ldapGroupMapper.Id)
 
 	data.Set("name", ldapGroupMapper.Name)
@@ -190,67 +192,71 @@ func setLdapGroupMapperData(keycloakClient *keycloak.KeycloakClient, data *schem
 	}
 }
 
-func resourceKeycloakLdapGroupMapperCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapGroupMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	ldapGroupMapper := getLdapGroupMapperFromData(keycloakClient, data)
+	ldapGroupMapper := getLdapGroupMapperFromData(ctx, keycloakClient, data)
 
-	err := keycloakClient.ValidateLdapGroupMapper(ldapGroupMapper)
+	err := keycloakClient.ValidateLdapGroupMapper(ctx, ldapGroupMapper)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.NewLdapGroupMapper(ldapGroupMapper)
+	err = keycloakClient.NewLdapGroupMapper(ctx, ldapGroupMapper)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	setLdapGroupMapperData(keycloakClient, data, ldapGroupMapper)
+	setLdapGroupMapperData(ctx, keycloakClient, data, ldapGroupMapper)
 
-	return resourceKeycloakLdapGroupMapperRead(data, meta)
+	return resourceKeycloakLdapGroupMapperRead(ctx, data, meta)
 }
 
-func resourceKeycloakLdapGroupMapperRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapGroupMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	ldapGroupMapper, err := keycloakClient.GetLdapGroupMapper(realmId, id)
+	ldapGroupMapper, err := keycloakClient.GetLdapGroupMapper(ctx, realmId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
-	setLdapGroupMapperData(keycloakClient, data, ldapGroupMapper)
+	setLdapGroupMapperData(ctx, keycloakClient, data, ldapGroupMapper)
 
 	return nil
 }
 
-func resourceKeycloakLdapGroupMapperUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapGroupMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	ldapGroupMapper := getLdapGroupMapperFromData(keycloakClient, data)
+	ldapGroupMapper := getLdapGroupMapperFromData(ctx, keycloakClient, data)
 
-	err := keycloakClient.ValidateLdapGroupMapper(ldapGroupMapper)
+	err := keycloakClient.ValidateLdapGroupMapper(ctx, ldapGroupMapper)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.UpdateLdapGroupMapper(ldapGroupMapper)
+	err = keycloakClient.UpdateLdapGroupMapper(ctx, ldapGroupMapper)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	setLdapGroupMapperData(keycloakClient, data, ldapGroupMapper)
+	setLdapGroupMapperData(ctx, keycloakClient, data, ldapGroupMapper)
 
 	return nil
 }
 
-func resourceKeycloakLdapGroupMapperDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapGroupMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteLdapGroupMapper(realmId, id)
+	if err := keycloakClient.DeleteLdapGroupMapper(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
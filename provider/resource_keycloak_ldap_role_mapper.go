@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
@@ -16,13 +18,13 @@ var (
 
 func resourceKeycloakLdapRoleMapper() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakLdapRoleMapperCreate,
-		Read:   resourceKeycloakLdapRoleMapperRead,
-		Update: resourceKeycloakLdapRoleMapperUpdate,
-		Delete: resourceKeycloakLdapRoleMapperDelete,
+		CreateContext: resourceKeycloakLdapRoleMapperCreate,
+		ReadContext:   resourceKeycloakLdapRoleMapperRead,
+		UpdateContext: resourceKeycloakLdapRoleMapperUpdate,
+		DeleteContext: resourceKeycloakLdapRoleMapperDelete,
 		// This resource can be imported using {{realm}}/{{provider_id}}/{{mapper_id}}. The Provider and Mapper IDs are displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakLdapGenericMapperImport,
+			StateContext: resourceKeycloakLdapGenericMapperImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -154,35 +156,35 @@ func setLdapRoleMapperData(data *schema.ResourceData, ldapRoleMapper *keycloak.L
 	data.Set("client_id", ldapRoleMapper.ClientId)
 }
 
-func resourceKeycloakLdapRoleMapperCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapRoleMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	ldapRoleMapper := getLdapRoleMapperFromData(data)
 
-	err := keycloakClient.ValidateLdapRoleMapper(ldapRoleMapper)
+	err := keycloakClient.ValidateLdapRoleMapper(ctx, ldapRoleMapper)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.NewLdapRoleMapper(ldapRoleMapper)
+	err = keycloakClient.NewLdapRoleMapper(ctx, ldapRoleMapper)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setLdapRoleMapperData(data, ldapRoleMapper)
 
-	return resourceKeycloakLdapRoleMapperRead(data, meta)
+	return resourceKeycloakLdapRoleMapperRead(ctx, data, meta)
 }
 
-func resourceKeycloakLdapRoleMapperRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapRoleMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	ldapRoleMapper, err := keycloakClient.GetLdapRoleMapper(realmId, id)
+	ldapRoleMapper, err := keycloakClient.GetLdapRoleMapper(ctx, realmId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setLdapRoleMapperData(data, ldapRoleMapper)
@@ -190,19 +192,19 @@ func resourceKeycloakLdapRoleMapperRead(data *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceKeycloakLdapRoleMapperUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapRoleMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	ldapRoleMapper := getLdapRoleMapperFromData(data)
 
-	err := keycloakClient.ValidateLdapRoleMapper(ldapRoleMapper)
+	err := keycloakClient.ValidateLdapRoleMapper(ctx, ldapRoleMapper)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.UpdateLdapRoleMapper(ldapRoleMapper)
+	err = keycloakClient.UpdateLdapRoleMapper(ctx, ldapRoleMapper)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setLdapRoleMapperData(data, ldapRoleMapper)
@@ -210,11 +212,15 @@ func resourceKeycloakLdapRoleMapperUpdate(data *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceKeycloakLdapRoleMapperDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapRoleMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteLdapRoleMapper(realmId, id)
+	if err := keycloakClient.DeleteLdapRoleMapper(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
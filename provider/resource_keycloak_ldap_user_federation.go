@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
@@ -19,16 +21,17 @@ var (
 
 func resourceKeycloakLdapUserFederation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakLdapUserFederationCreate,
-		Read:   resourceKeycloakLdapUserFederationRead,
-		Update: resourceKeycloakLdapUserFederationUpdate,
-		Delete: resourceKeycloakLdapUserFederationDelete,
+		CreateContext: resourceKeycloakLdapUserFederationCreate,
+		ReadContext:   resourceKeycloakLdapUserFederationRead,
+		UpdateContext: resourceKeycloakLdapUserFederationUpdate,
+		DeleteContext: resourceKeycloakLdapUserFederationDelete,
 		// If this resource uses authentication, then this resource must be imported using the syntax {{realm_id}}/{{provider_id}}/{{bind_credential}}
 		// Otherwise, this resource can be imported using {{realm}}/{{provider_id}}.
 		// The Provider ID is displayed in the GUI when editing this provider
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakLdapUserFederationImport,
+			StateContext: resourceKeycloakLdapUserFederationImport,
 		},
+		Timeouts: slowResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -485,40 +488,40 @@ func setLdapUserFederationData(data *schema.ResourceData, ldap *keycloak.LdapUse
 	}
 }
 
-func resourceKeycloakLdapUserFederationCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapUserFederationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	ldap := getLdapUserFederationFromData(data)
 
-	err := keycloakClient.ValidateLdapUserFederation(ldap)
+	err := keycloakClient.ValidateLdapUserFederation(ctx, ldap)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.NewLdapUserFederation(ldap)
+	err = keycloakClient.NewLdapUserFederation(ctx, ldap)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.DeleteLdapUserFederationMappers(ldap.RealmId, ldap.Id)
+	err = keycloakClient.DeleteLdapUserFederationMappers(ctx, ldap.RealmId, ldap.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setLdapUserFederationData(data, ldap)
 
-	return resourceKeycloakLdapUserFederationRead(data, meta)
+	return resourceKeycloakLdapUserFederationRead(ctx, data, meta)
 }
 
-func resourceKeycloakLdapUserFederationRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapUserFederationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	ldap, err := keycloakClient.GetLdapUserFederation(realmId, id)
+	ldap, err := keycloakClient.GetLdapUserFederation(ctx, realmId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	// ldap.BindCredential = data.Get("bind_credential").(string) // we can't trust the API to set this field correctly since it just responds with "**********"
@@ -527,19 +530,19 @@ func resourceKeycloakLdapUserFederationRead(data *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceKeycloakLdapUserFederationUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapUserFederationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	ldap := getLdapUserFederationFromData(data)
 
-	err := keycloakClient.ValidateLdapUserFederation(ldap)
+	err := keycloakClient.ValidateLdapUserFederation(ctx, ldap)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.UpdateLdapUserFederation(ldap)
+	err = keycloakClient.UpdateLdapUserFederation(ctx, ldap)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setLdapUserFederationData(data, ldap)
@@ -547,16 +550,20 @@ func resourceKeycloakLdapUserFederationUpdate(data *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceKeycloakLdapUserFederationDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakLdapUserFederationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteLdapUserFederation(realmId, id)
+	if err := keycloakClient.DeleteLdapUserFederation(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakLdapUserFederationImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakLdapUserFederationImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	var realmId, id string
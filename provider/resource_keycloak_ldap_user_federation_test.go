@@ -108,7 +108,7 @@ func TestAccKeycloakLdapUserFederation_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteLdapUserFederation(ldap.RealmId, ldap.Id)
+					err := keycloakClient.DeleteLdapUserFederation(testCtx, ldap.RealmId, ldap.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -578,7 +578,7 @@ func testAccCheckKeycloakLdapUserFederationDestroy() resource.TestCheckFunc {
 			id := rs.Primary.ID
 			realm := rs.Primary.Attributes["realm_id"]
 
-			ldap, _ := keycloakClient.GetLdapUserFederation(realm, id)
+			ldap, _ := keycloakClient.GetLdapUserFederation(testCtx, realm, id)
 			if ldap != nil {
 				return fmt.Errorf("ldap config with id %s still exists", id)
 			}
@@ -597,7 +597,7 @@ func getLdapUserFederationFromState(s *terraform.State, resourceName string) (*k
 	id := rs.Primary.ID
 	realm := rs.Primary.Attributes["realm_id"]
 
-	ldap, err := keycloakClient.GetLdapUserFederation(realm, id)
+	ldap, err := keycloakClient.GetLdapUserFederation(testCtx, realm, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting ldap config with id %s: %s", id, err)
 	}
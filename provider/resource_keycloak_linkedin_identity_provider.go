@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakLinkedinIdentityProvider() *schema.Resource {
+	linkedinSchema := map[string]*schema.Schema{
+		"profile_projection": { //profileProjection
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The projection used to fetch the profile, such as '(id,firstName,lastName,profilePicture(displayImage~:playableStreams))'. Leave this empty to fetch the default fields. Only used by the deprecated linkedin provider, not by linkedin-openid-connect.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("linkedin", linkedinSchema, map[string]string{
+		"profile_projection": "profileProjection",
+	})
+}
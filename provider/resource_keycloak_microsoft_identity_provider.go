@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakMicrosoftIdentityProvider() *schema.Resource {
+	microsoftSchema := map[string]*schema.Schema{
+		"tenant_id": { //tenantId
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The id of the Azure AD tenant users have to belong to. Leave this empty to allow users of any tenant and personal Microsoft accounts.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("microsoft", microsoftSchema, map[string]string{
+		"tenant_id": "tenantId",
+	})
+}
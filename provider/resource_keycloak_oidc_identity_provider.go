@@ -1,11 +1,27 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/imdario/mergo"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
+// The endpoints that are taken from the discovery document unless they are set, along with the config key Keycloak
+// imports them as
+var oidcDiscoveredEndpoints = map[string]string{
+	"authorization_url": "authorizationUrl",
+	"token_url":         "tokenUrl",
+	"jwks_url":          "jwksUrl",
+	"user_info_url":     "userInfoUrl",
+	"logout_url":        "logoutUrl",
+}
+
 func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 	oidcSchema := map[string]*schema.Schema{
 		"provider_id": {
@@ -26,10 +42,23 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 			Default:     false,
 			Description: "Enable/disable signature validation of external IDP signatures.",
 		},
+		"discovery_url": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The URL of the OpenID Connect discovery document, such as https://example.com/.well-known/openid-configuration. The endpoints that aren't set are taken from it.",
+		},
+		"discovered_endpoints": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "The endpoints found in the discovery document, which are refreshed on every plan.",
+		},
 		"authorization_url": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "OIDC authorization URL.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppressDiscoveredEndpointDiff,
+			Description:      "OIDC authorization URL. Required, unless discovery_url is set.",
 		},
 		"client_id": {
 			Type:        schema.TypeString,
@@ -46,14 +75,16 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 			Description: "Client Secret.",
 		},
 		"user_info_url": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "User Info URL",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppressDiscoveredEndpointDiff,
+			Description:      "User Info URL",
 		},
 		"jwks_url": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "JSON Web Key Set URL",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppressDiscoveredEndpointDiff,
+			Description:      "JSON Web Key Set URL",
 		},
 		"hide_on_login_page": {
 			Type:        schema.TypeBool,
@@ -62,14 +93,16 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 			Description: "Hide On Login Page.",
 		},
 		"token_url": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Token URL.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppressDiscoveredEndpointDiff,
+			Description:      "Token URL. Required, unless discovery_url is set.",
 		},
 		"logout_url": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Logout URL",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppressDiscoveredEndpointDiff,
+			Description:      "Logout URL",
 		},
 		"login_hint": {
 			Type:        schema.TypeString,
@@ -103,31 +136,130 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 	}
 	oidcResource := resourceKeycloakIdentityProvider()
 	oidcResource.Schema = mergeSchemas(oidcResource.Schema, oidcSchema)
-	oidcResource.Create = resourceKeycloakIdentityProviderCreate(getOidcIdentityProviderFromData, setOidcIdentityProviderData)
-	oidcResource.Read = resourceKeycloakIdentityProviderRead(setOidcIdentityProviderData)
-	oidcResource.Update = resourceKeycloakIdentityProviderUpdate(getOidcIdentityProviderFromData, setOidcIdentityProviderData)
+	oidcResource.CreateContext = discoverOidcEndpointsBeforeApply(resourceKeycloakIdentityProviderCreate(getOidcIdentityProviderFromData, setOidcIdentityProviderData))
+	oidcResource.ReadContext = resourceKeycloakIdentityProviderRead(setOidcIdentityProviderData)
+	oidcResource.UpdateContext = discoverOidcEndpointsBeforeApply(resourceKeycloakIdentityProviderUpdate(getOidcIdentityProviderFromData, setOidcIdentityProviderData))
+	oidcResource.CustomizeDiff = resourceKeycloakOidcIdentityProviderCustomizeDiff
 	return oidcResource
 }
 
+func discoverOidcEndpoints(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realm, discoveryUrl string) (map[string]interface{}, error) {
+	config, err := keycloakClient.ImportIdentityProviderConfig(ctx, realm, "oidc", discoveryUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	discovered := map[string]interface{}{}
+	for attribute, configKey := range oidcDiscoveredEndpoints {
+		if value := config[configKey]; value != "" {
+			discovered[attribute] = value
+		}
+	}
+
+	return discovered, nil
+}
+
+// The discovery document is fetched on every plan, so moved endpoints show up as a change to discovered_endpoints
+func resourceKeycloakOidcIdentityProviderCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if !d.NewValueKnown("discovery_url") || !d.NewValueKnown("realm") {
+		return d.SetNewComputed("discovered_endpoints")
+	}
+
+	discoveryUrl := d.Get("discovery_url").(string)
+	if discoveryUrl == "" {
+		for _, attribute := range []string{"authorization_url", "token_url"} {
+			if d.NewValueKnown(attribute) && d.Get(attribute).(string) == "" {
+				return fmt.Errorf("%s is required, unless discovery_url is set", attribute)
+			}
+		}
+		if len(d.Get("discovered_endpoints").(map[string]interface{})) != 0 {
+			return d.SetNew("discovered_endpoints", map[string]interface{}{})
+		}
+		return nil
+	}
+
+	discovered, err := discoverOidcEndpoints(ctx, keycloakClient, d.Get("realm").(string), discoveryUrl)
+	if err != nil {
+		// the realm may be created along with the identity provider, in which case the endpoints are discovered during apply
+		if keycloak.ErrorIs404(err) {
+			return d.SetNewComputed("discovered_endpoints")
+		}
+		return fmt.Errorf("error discovering endpoints from %s: %w", discoveryUrl, err)
+	}
+
+	if !reflect.DeepEqual(d.Get("discovered_endpoints").(map[string]interface{}), discovered) {
+		return d.SetNew("discovered_endpoints", discovered)
+	}
+
+	return nil
+}
+
+func discoverOidcEndpointsBeforeApply(apply func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		discoveryUrl := data.Get("discovery_url").(string)
+		if discoveryUrl != "" && len(data.Get("discovered_endpoints").(map[string]interface{})) == 0 {
+			discovered, err := discoverOidcEndpoints(ctx, keycloakClient, data.Get("realm").(string), discoveryUrl)
+			if err != nil {
+				return diag.Errorf("error discovering endpoints from %s: %s", discoveryUrl, err)
+			}
+			data.Set("discovered_endpoints", discovered)
+		}
+
+		return apply(ctx, data, meta)
+	}
+}
+
+// Endpoints that aren't set are stored as empty, as long as they match the discovered ones
+func suppressDiscoveredEndpointDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old != "" || d.Get("discovery_url").(string) == "" {
+		return false
+	}
+
+	discovered, _ := d.Get("discovered_endpoints").(map[string]interface{})[k].(string)
+	return new == discovered
+}
+
+func getOidcEndpointFromData(data *schema.ResourceData, attribute string) string {
+	if value := data.Get(attribute).(string); value != "" || data.Get("discovery_url").(string) == "" {
+		return value
+	}
+
+	discovered, _ := data.Get("discovered_endpoints").(map[string]interface{})[attribute].(string)
+	return discovered
+}
+
+func setOidcEndpointData(data *schema.ResourceData, attribute, value string) {
+	discovered, _ := data.Get("discovered_endpoints").(map[string]interface{})[attribute].(string)
+	if data.Get("discovery_url").(string) != "" && value == discovered {
+		value = ""
+	}
+
+	data.Set(attribute, value)
+}
+
 func getOidcIdentityProviderFromData(data *schema.ResourceData) (*keycloak.IdentityProvider, error) {
 	rec, defaultConfig := getIdentityProviderFromData(data)
 	rec.ProviderId = data.Get("provider_id").(string)
-	_, useJwksUrl := data.GetOk("jwks_url")
+	jwksUrl := getOidcEndpointFromData(data, "jwks_url")
 
 	oidcIdentityProviderConfig := &keycloak.IdentityProviderConfig{
 		BackchannelSupported:        keycloak.KeycloakBoolQuoted(data.Get("backchannel_supported").(bool)),
 		ValidateSignature:           keycloak.KeycloakBoolQuoted(data.Get("validate_signature").(bool)),
-		AuthorizationUrl:            data.Get("authorization_url").(string),
+		AuthorizationUrl:            getOidcEndpointFromData(data, "authorization_url"),
 		ClientId:                    data.Get("client_id").(string),
 		ClientSecret:                data.Get("client_secret").(string),
 		HideOnLoginPage:             keycloak.KeycloakBoolQuoted(data.Get("hide_on_login_page").(bool)),
-		TokenUrl:                    data.Get("token_url").(string),
-		LogoutUrl:                   data.Get("logout_url").(string),
+		TokenUrl:                    getOidcEndpointFromData(data, "token_url"),
+		LogoutUrl:                   getOidcEndpointFromData(data, "logout_url"),
 		UILocales:                   keycloak.KeycloakBoolQuoted(data.Get("ui_locales").(bool)),
 		LoginHint:                   data.Get("login_hint").(string),
-		JwksUrl:                     data.Get("jwks_url").(string),
-		UserInfoUrl:                 data.Get("user_info_url").(string),
-		UseJwksUrl:                  keycloak.KeycloakBoolQuoted(useJwksUrl),
+		JwksUrl:                     jwksUrl,
+		UserInfoUrl:                 getOidcEndpointFromData(data, "user_info_url"),
+		UseJwksUrl:                  keycloak.KeycloakBoolQuoted(jwksUrl != ""),
 		DisableUserInfo:             keycloak.KeycloakBoolQuoted(data.Get("disable_user_info").(bool)),
 		DefaultScope:                data.Get("default_scopes").(string),
 		AcceptsPromptNoneForwFrmClt: keycloak.KeycloakBoolQuoted(data.Get("accepts_prompt_none_forward_from_client").(bool)),
@@ -145,15 +277,15 @@ func getOidcIdentityProviderFromData(data *schema.ResourceData) (*keycloak.Ident
 func setOidcIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider) error {
 	setIdentityProviderData(data, identityProvider)
 	data.Set("backchannel_supported", identityProvider.Config.BackchannelSupported)
-	data.Set("jwks_url", identityProvider.Config.JwksUrl)
-	data.Set("logout_url", identityProvider.Config.LogoutUrl)
+	setOidcEndpointData(data, "jwks_url", identityProvider.Config.JwksUrl)
+	setOidcEndpointData(data, "logout_url", identityProvider.Config.LogoutUrl)
 	data.Set("validate_signature", identityProvider.Config.ValidateSignature)
-	data.Set("authorization_url", identityProvider.Config.AuthorizationUrl)
+	setOidcEndpointData(data, "authorization_url", identityProvider.Config.AuthorizationUrl)
 	data.Set("client_id", identityProvider.Config.ClientId)
 	data.Set("disable_user_info", identityProvider.Config.DisableUserInfo)
-	data.Set("user_info_url", identityProvider.Config.UserInfoUrl)
+	setOidcEndpointData(data, "user_info_url", identityProvider.Config.UserInfoUrl)
 	data.Set("hide_on_login_page", identityProvider.Config.HideOnLoginPage)
-	data.Set("token_url", identityProvider.Config.TokenUrl)
+	setOidcEndpointData(data, "token_url", identityProvider.Config.TokenUrl)
 	data.Set("login_hint", identityProvider.Config.LoginHint)
 	data.Set("ui_locales", identityProvider.Config.UILocales)
 	data.Set("default_scopes", identityProvider.Config.DefaultScope)
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strconv"
 	"testing"
@@ -100,7 +102,7 @@ func TestAccKeycloakOidcIdentityProvider_createAfterManualDestroy(t *testing.T)
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteIdentityProvider(oidc.Realm, oidc.Alias)
+					err := keycloakClient.DeleteIdentityProvider(testCtx, oidc.Realm, oidc.Alias)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -160,6 +162,80 @@ func TestAccKeycloakOidcIdentityProvider_basicUpdateAll(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOidcIdentityProvider_endpointsRequired(t *testing.T) {
+	oidcName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOidcIdentityProvider_withoutEndpoints(oidcName),
+				ExpectError: regexp.MustCompile("authorization_url is required, unless discovery_url is set"),
+			},
+		},
+	})
+}
+
+// The discovery document is served on the loopback interface, which only the in-memory Keycloak server can reach
+func TestAccKeycloakOidcIdentityProvider_discovery(t *testing.T) {
+	if testServer == nil {
+		t.Skip("a Keycloak server can't reach the discovery document served by the test")
+	}
+
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	tokenEndpoint := "https://idp.example.com/token"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{
+			"issuer": "https://idp.example.com",
+			"authorization_endpoint": "https://idp.example.com/authorize",
+			"token_endpoint": "%s",
+			"userinfo_endpoint": "https://idp.example.com/userinfo",
+			"end_session_endpoint": "https://idp.example.com/logout",
+			"jwks_uri": "https://idp.example.com/jwks"
+		}`, tokenEndpoint)
+	}))
+	defer server.Close()
+
+	discoveryUrl := server.URL + "/.well-known/openid-configuration"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			// discovered endpoints are kept out of the attributes, so only overridden ones show up there
+			{
+				Config: testKeycloakOidcIdentityProvider_discovery(alias, discoveryUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderDiscoveredEndpoints("keycloak_oidc_identity_provider.oidc", tokenEndpoint),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "token_url", ""),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "logout_url", "https://idp.example.com/custom-logout"),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "discovered_endpoints.%", "5"),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "discovered_endpoints.logout_url", "https://idp.example.com/logout"),
+				),
+			},
+			// moved endpoints show up in the plan
+			{
+				PreConfig: func() {
+					tokenEndpoint = "https://idp.example.com/oauth2/token"
+				},
+				Config:             testKeycloakOidcIdentityProvider_discovery(alias, discoveryUrl),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testKeycloakOidcIdentityProvider_withoutEndpoints(alias),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("authorization_url is required, unless discovery_url is set"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakOidcIdentityProviderExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
@@ -171,6 +247,25 @@ func testAccCheckKeycloakOidcIdentityProviderExists(resourceName string) resourc
 	}
 }
 
+func testAccCheckKeycloakOidcIdentityProviderDiscoveredEndpoints(resourceName, tokenEndpoint string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedOidc, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if fetchedOidc.Config.TokenUrl != tokenEndpoint || fetchedOidc.Config.JwksUrl != "https://idp.example.com/jwks" || !fetchedOidc.Config.UseJwksUrl {
+			return fmt.Errorf("expected the discovered endpoints to be used, got %+v", fetchedOidc.Config)
+		}
+
+		if fetchedOidc.Config.LogoutUrl != "https://idp.example.com/custom-logout" {
+			return fmt.Errorf("expected the logout URL to be overridden, got %s", fetchedOidc.Config.LogoutUrl)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOidcIdentityProviderFetch(resourceName string, oidc *keycloak.IdentityProvider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		fetchedOidc, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
@@ -225,7 +320,7 @@ func testAccCheckKeycloakOidcIdentityProviderDestroy() resource.TestCheckFunc {
 			id := rs.Primary.ID
 			realm := rs.Primary.Attributes["realm"]
 
-			oidc, _ := keycloakClient.GetIdentityProvider(realm, id)
+			oidc, _ := keycloakClient.GetIdentityProvider(testCtx, realm, id)
 			if oidc != nil {
 				return fmt.Errorf("oidc config with id %s still exists", id)
 			}
@@ -244,7 +339,7 @@ func getKeycloakOidcIdentityProviderFromState(s *terraform.State, resourceName s
 	realm := rs.Primary.Attributes["realm"]
 	alias := rs.Primary.Attributes["alias"]
 
-	oidc, err := keycloakClient.GetIdentityProvider(realm, alias)
+	oidc, err := keycloakClient.GetIdentityProvider(testCtx, realm, alias)
 	if err != nil {
 		return nil, fmt.Errorf("error getting oidc identity provider config with alias %s: %s", alias, err)
 	}
@@ -269,6 +364,38 @@ resource "keycloak_oidc_identity_provider" "oidc" {
 	`, testAccRealm.Realm, oidc)
 }
 
+func testKeycloakOidcIdentityProvider_withoutEndpoints(oidc string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+}
+	`, testAccRealm.Realm, oidc)
+}
+
+func testKeycloakOidcIdentityProvider_discovery(alias, discoveryUrl string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+	discovery_url = "%s"
+	logout_url    = "https://idp.example.com/custom-logout"
+}
+	`, testAccRealm.Realm, alias, discoveryUrl)
+}
+
 func testKeycloakOidcIdentityProvider_extra_config(alias, configKey, configValue string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
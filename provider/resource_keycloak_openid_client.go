@@ -7,6 +7,8 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,15 +22,20 @@ var (
 	keycloakOpenidClientPkceCodeChallengeMethod              = []string{"", "plain", "S256"}
 )
 
+// Validation errors for fields that don't share their name with an attribute
+var openidClientValidationAttributePaths = map[string]cty.Path{
+	"BearerOnly": cty.GetAttrPath("access_type"),
+}
+
 func resourceKeycloakOpenidClient() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenidClientCreate,
-		Read:   resourceKeycloakOpenidClientRead,
-		Delete: resourceKeycloakOpenidClientDelete,
-		Update: resourceKeycloakOpenidClientUpdate,
+		CreateContext: resourceKeycloakOpenidClientCreate,
+		ReadContext:   resourceKeycloakOpenidClientRead,
+		DeleteContext: resourceKeycloakOpenidClientDelete,
+		UpdateContext: resourceKeycloakOpenidClientUpdate,
 		// This resource can be imported using {{realm}}/{{client_id}}. The Client ID is displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakOpenidClientImport,
+			StateContext: resourceKeycloakOpenidClientImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"client_id": {
@@ -328,10 +335,10 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 	return openidClient, nil
 }
 
-func setOpenidClientData(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
+func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
 	var serviceAccountUserId string
 	if client.ServiceAccountsEnabled {
-		serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(client.RealmId, client.Id)
+		serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(ctx, client.RealmId, client.Id)
 		if err != nil {
 			return err
 		}
@@ -395,106 +402,104 @@ func setOpenidClientData(keycloakClient *keycloak.KeycloakClient, data *schema.R
 	return nil
 }
 
-func resourceKeycloakOpenidClientCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	client, err := getOpenidClientFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.ValidateOpenidClient(client)
+	err = keycloakClient.ValidateOpenidClient(ctx, client)
 	if err != nil {
-		return err
+		return handleValidationError(err, openidClientValidationAttributePaths)
 	}
 
-	err = keycloakClient.NewOpenidClient(client)
+	err = keycloakClient.NewOpenidClient(ctx, client)
 	if err != nil {
-		var ae *keycloak.ApiError
-		if !errors.As(err, &ae) {
-			return err
-		}
-
-		if ae.Code != 409 {
-			return err
+		if !keycloak.ErrorIs409(err) {
+			return diag.FromErr(err)
 		}
 
 		log.Println("resource already exists, may be hardcoded, try to update")
-		cli, err1 := keycloakClient.GetOpenidClientByClientId(client.RealmId, client.ClientId)
+		cli, err1 := keycloakClient.GetOpenidClientByClientId(ctx, client.RealmId, client.ClientId)
 		if err1 != nil {
-			return err1
+			return diag.FromErr(err1)
 		}
 		data.SetId(cli.Id)
 		client.Id = cli.Id
-		err = resourceKeycloakOpenidClientUpdate(data, meta)
-		if err != nil {
-			return err
+		if diags := resourceKeycloakOpenidClientUpdate(ctx, data, meta); diags.HasError() {
+			return diags
 		}
 	}
 
-	err = setOpenidClientData(keycloakClient, data, client)
+	err = setOpenidClientData(ctx, keycloakClient, data, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceKeycloakOpenidClientRead(data, meta)
+	return resourceKeycloakOpenidClientRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	client, err := keycloakClient.GetOpenidClient(realmId, id)
+	client, err := keycloakClient.GetOpenidClient(ctx, realmId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
-	err = setOpenidClientData(keycloakClient, data, client)
+	err = setOpenidClientData(ctx, keycloakClient, data, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceKeycloakOpenidClientUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	client, err := getOpenidClientFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.ValidateOpenidClient(client)
+	err = keycloakClient.ValidateOpenidClient(ctx, client)
 	if err != nil {
-		return err
+		return handleValidationError(err, openidClientValidationAttributePaths)
 	}
 
-	err = keycloakClient.UpdateOpenidClient(client)
+	err = keycloakClient.UpdateOpenidClient(ctx, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = setOpenidClientData(keycloakClient, data, client)
+	err = setOpenidClientData(ctx, keycloakClient, data, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceKeycloakOpenidClientDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteOpenidClient(realmId, id)
+	if err := keycloakClient.DeleteOpenidClient(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakOpenidClientImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakOpenidClientImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{openidClientId}}")
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
@@ -15,13 +17,14 @@ var (
 
 func resourceKeycloakOpenidClientAuthorizationPermission() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenidClientAuthorizationPermissionCreate,
-		Read:   resourceKeycloakOpenidClientAuthorizationPermissionRead,
-		Delete: resourceKeycloakOpenidClientAuthorizationPermissionDelete,
-		Update: resourceKeycloakOpenidClientAuthorizationPermissionUpdate,
+		CreateContext: resourceKeycloakOpenidClientAuthorizationPermissionCreate,
+		ReadContext:   resourceKeycloakOpenidClientAuthorizationPermissionRead,
+		DeleteContext: resourceKeycloakOpenidClientAuthorizationPermissionDelete,
+		UpdateContext: resourceKeycloakOpenidClientAuthorizationPermissionUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakOpenidClientAuthorizationPermissionImport,
+			StateContext: resourceKeycloakOpenidClientAuthorizationPermissionImport,
 		},
+		Timeouts: slowResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"resource_server_id": {
 				Type:     schema.TypeString,
@@ -120,31 +123,31 @@ func setOpenidClientAuthorizationPermissionData(data *schema.ResourceData, permi
 	data.Set("resources", permission.Resources)
 }
 
-func resourceKeycloakOpenidClientAuthorizationPermissionCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationPermissionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	permission := getOpenidClientAuthorizationPermissionFromData(data)
 
-	err := keycloakClient.NewOpenidClientAuthorizationPermission(permission)
+	err := keycloakClient.NewOpenidClientAuthorizationPermission(ctx, permission)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setOpenidClientAuthorizationPermissionData(data, permission)
 
-	return resourceKeycloakOpenidClientAuthorizationPermissionRead(data, meta)
+	return resourceKeycloakOpenidClientAuthorizationPermissionRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientAuthorizationPermissionRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationPermissionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	resourceServerId := data.Get("resource_server_id").(string)
 	id := data.Id()
 
-	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(realmId, resourceServerId, id)
+	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, resourceServerId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setOpenidClientAuthorizationPermissionData(data, permission)
@@ -152,14 +155,14 @@ func resourceKeycloakOpenidClientAuthorizationPermissionRead(data *schema.Resour
 	return nil
 }
 
-func resourceKeycloakOpenidClientAuthorizationPermissionUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationPermissionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	permission := getOpenidClientAuthorizationPermissionFromData(data)
 
-	err := keycloakClient.UpdateOpenidClientAuthorizationPermission(permission)
+	err := keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setOpenidClientAuthorizationPermissionData(data, permission)
@@ -167,17 +170,21 @@ func resourceKeycloakOpenidClientAuthorizationPermissionUpdate(data *schema.Reso
 	return nil
 }
 
-func resourceKeycloakOpenidClientAuthorizationPermissionDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationPermissionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	resourceServerId := data.Get("resource_server_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteOpenidClientAuthorizationPermission(realmId, resourceServerId, id)
+	if err := keycloakClient.DeleteOpenidClientAuthorizationPermission(ctx, realmId, resourceServerId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakOpenidClientAuthorizationPermissionImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakOpenidClientAuthorizationPermissionImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 3 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{resourceServerId}}/{{permissionId}}")
@@ -50,7 +50,7 @@ func TestAccKeycloakOpenidClientAuthorizationPermission_createAfterManualDestroy
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteOpenidClientAuthorizationPermission(authorizationPermission.RealmId, authorizationPermission.ResourceServerId, authorizationPermission.Id)
+					err := keycloakClient.DeleteOpenidClientAuthorizationPermission(testCtx, authorizationPermission.RealmId, authorizationPermission.ResourceServerId, authorizationPermission.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -133,7 +133,7 @@ func testAccCheckKeycloakOpenidClientAuthorizationPermissionDestroy() resource.T
 			resourceServerId := rs.Primary.Attributes["resource_server_id"]
 			id := rs.Primary.ID
 
-			authorizationPermission, _ := keycloakClient.GetOpenidClientAuthorizationPermission(realm, resourceServerId, id)
+			authorizationPermission, _ := keycloakClient.GetOpenidClientAuthorizationPermission(testCtx, realm, resourceServerId, id)
 			if authorizationPermission != nil {
 				return fmt.Errorf("test config with id %s still exists", id)
 			}
@@ -153,7 +153,7 @@ func getKeycloakOpenidClientAuthorizationPermissionFromState(s *terraform.State,
 	resourceServerId := rs.Primary.Attributes["resource_server_id"]
 	id := rs.Primary.ID
 
-	authorizationPermission, err := keycloakClient.GetOpenidClientAuthorizationPermission(realm, resourceServerId, id)
+	authorizationPermission, err := keycloakClient.GetOpenidClientAuthorizationPermission(testCtx, realm, resourceServerId, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting authorization permission config with id %s: %s", id, err)
 	}
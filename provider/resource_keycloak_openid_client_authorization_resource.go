@@ -1,22 +1,25 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakOpenidClientAuthorizationResource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenidClientAuthorizationResourceCreate,
-		Read:   resourceKeycloakOpenidClientAuthorizationResourceRead,
-		Delete: resourceKeycloakOpenidClientAuthorizationResourceDelete,
-		Update: resourceKeycloakOpenidClientAuthorizationResourceUpdate,
+		CreateContext: resourceKeycloakOpenidClientAuthorizationResourceCreate,
+		ReadContext:   resourceKeycloakOpenidClientAuthorizationResourceRead,
+		DeleteContext: resourceKeycloakOpenidClientAuthorizationResourceDelete,
+		UpdateContext: resourceKeycloakOpenidClientAuthorizationResourceUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakOpenidClientAuthorizationResourceImport,
+			StateContext: resourceKeycloakOpenidClientAuthorizationResourceImport,
 		},
+		Timeouts: slowResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"resource_server_id": {
 				Type:     schema.TypeString,
@@ -122,31 +125,31 @@ func setOpenidClientAuthorizationResourceData(data *schema.ResourceData, resourc
 	data.Set("scopes", scopes)
 }
 
-func resourceKeycloakOpenidClientAuthorizationResourceCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationResourceCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	resource := getOpenidClientAuthorizationResourceFromData(data)
 
-	err := keycloakClient.NewOpenidClientAuthorizationResource(resource)
+	err := keycloakClient.NewOpenidClientAuthorizationResource(ctx, resource)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setOpenidClientAuthorizationResourceData(data, resource)
 
-	return resourceKeycloakOpenidClientAuthorizationResourceRead(data, meta)
+	return resourceKeycloakOpenidClientAuthorizationResourceRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientAuthorizationResourceRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationResourceRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	resourceServerId := data.Get("resource_server_id").(string)
 	id := data.Id()
 
-	resource, err := keycloakClient.GetOpenidClientAuthorizationResource(realmId, resourceServerId, id)
+	resource, err := keycloakClient.GetOpenidClientAuthorizationResource(ctx, realmId, resourceServerId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setOpenidClientAuthorizationResourceData(data, resource)
@@ -154,14 +157,14 @@ func resourceKeycloakOpenidClientAuthorizationResourceRead(data *schema.Resource
 	return nil
 }
 
-func resourceKeycloakOpenidClientAuthorizationResourceUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationResourceUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	resource := getOpenidClientAuthorizationResourceFromData(data)
 
-	err := keycloakClient.UpdateOpenidClientAuthorizationResource(resource)
+	err := keycloakClient.UpdateOpenidClientAuthorizationResource(ctx, resource)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setOpenidClientAuthorizationResourceData(data, resource)
@@ -169,17 +172,21 @@ func resourceKeycloakOpenidClientAuthorizationResourceUpdate(data *schema.Resour
 	return nil
 }
 
-func resourceKeycloakOpenidClientAuthorizationResourceDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationResourceDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	resourceServerId := data.Get("resource_server_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteOpenidClientAuthorizationResource(realmId, resourceServerId, id)
+	if err := keycloakClient.DeleteOpenidClientAuthorizationResource(ctx, realmId, resourceServerId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakOpenidClientAuthorizationResourceImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakOpenidClientAuthorizationResourceImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 3 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{resourceServerId}}/{{authorizationResourceId}}")
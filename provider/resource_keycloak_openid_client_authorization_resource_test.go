@@ -46,7 +46,7 @@ func TestAccKeycloakOpenidClientAuthorizationResource_createAfterManualDestroy(t
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteOpenidClientAuthorizationResource(authorizationResource.RealmId, authorizationResource.ResourceServerId, authorizationResource.Id)
+					err := keycloakClient.DeleteOpenidClientAuthorizationResource(testCtx, authorizationResource.RealmId, authorizationResource.ResourceServerId, authorizationResource.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -135,7 +135,7 @@ func testAccCheckKeycloakOpenidClientAuthorizationResourceDestroy() resource.Tes
 			resourceServerId := rs.Primary.Attributes["resource_server_id"]
 			id := rs.Primary.ID
 
-			authorizationResource, _ := keycloakClient.GetOpenidClientAuthorizationResource(realm, resourceServerId, id)
+			authorizationResource, _ := keycloakClient.GetOpenidClientAuthorizationResource(testCtx, realm, resourceServerId, id)
 			if authorizationResource != nil {
 				return fmt.Errorf("test config with id %s still exists", id)
 			}
@@ -155,7 +155,7 @@ func getKeycloakOpenidClientAuthorizationResourceFromState(s *terraform.State, r
 	resourceServerId := rs.Primary.Attributes["resource_server_id"]
 	id := rs.Primary.ID
 
-	authorizationResource, err := keycloakClient.GetOpenidClientAuthorizationResource(realm, resourceServerId, id)
+	authorizationResource, err := keycloakClient.GetOpenidClientAuthorizationResource(testCtx, realm, resourceServerId, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting authorization resource config with id %s: %s", id, err)
 	}
@@ -46,7 +46,7 @@ func TestAccKeycloakOpenidClientAuthorizationScope_createAfterManualDestroy(t *t
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteOpenidClientAuthorizationScope(authorizationScope.RealmId, authorizationScope.ResourceServerId, authorizationScope.Id)
+					err := keycloakClient.DeleteOpenidClientAuthorizationScope(testCtx, authorizationScope.RealmId, authorizationScope.ResourceServerId, authorizationScope.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -130,7 +130,7 @@ func testAccCheckKeycloakOpenidClientAuthorizationScopeDestroy() resource.TestCh
 			resourceServerId := rs.Primary.Attributes["resource_server_id"]
 			id := rs.Primary.ID
 
-			authorizationScope, _ := keycloakClient.GetOpenidClientAuthorizationScope(realm, resourceServerId, id)
+			authorizationScope, _ := keycloakClient.GetOpenidClientAuthorizationScope(testCtx, realm, resourceServerId, id)
 			if authorizationScope != nil {
 				return fmt.Errorf("test config with id %s still exists", id)
 			}
@@ -150,7 +150,7 @@ func getKeycloakOpenidClientAuthorizationScopeFromState(s *terraform.State, scop
 	resourceServerId := rs.Primary.Attributes["resource_server_id"]
 	id := rs.Primary.ID
 
-	authorizationScope, err := keycloakClient.GetOpenidClientAuthorizationScope(realm, resourceServerId, id)
+	authorizationScope, err := keycloakClient.GetOpenidClientAuthorizationScope(testCtx, realm, resourceServerId, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting authorization scope config with id %s: %s", id, err)
 	}
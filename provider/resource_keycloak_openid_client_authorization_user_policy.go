@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
@@ -8,13 +11,14 @@ import (
 
 func resourceKeycloakOpenidClientAuthorizationUserPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenidClientAuthorizationUserPolicyCreate,
-		Read:   resourceKeycloakOpenidClientAuthorizationUserPolicyRead,
-		Delete: resourceKeycloakOpenidClientAuthorizationUserPolicyDelete,
-		Update: resourceKeycloakOpenidClientAuthorizationUserPolicyUpdate,
+		CreateContext: resourceKeycloakOpenidClientAuthorizationUserPolicyCreate,
+		ReadContext:   resourceKeycloakOpenidClientAuthorizationUserPolicyRead,
+		DeleteContext: resourceKeycloakOpenidClientAuthorizationUserPolicyDelete,
+		UpdateContext: resourceKeycloakOpenidClientAuthorizationUserPolicyUpdate,
 		Importer: &schema.ResourceImporter{
-			State: genericResourcePolicyImport,
+			StateContext: genericResourcePolicyImport,
 		},
+		Timeouts: slowResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"resource_server_id": {
 				Type:     schema.TypeString,
@@ -84,31 +88,31 @@ func setOpenidClientAuthorizationUserPolicyResourceData(data *schema.ResourceDat
 	data.Set("users", policy.Users)
 }
 
-func resourceKeycloakOpenidClientAuthorizationUserPolicyCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationUserPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	resource := getOpenidClientAuthorizationUserPolicyResourceFromData(data)
 
-	err := keycloakClient.NewOpenidClientAuthorizationUserPolicy(resource)
+	err := keycloakClient.NewOpenidClientAuthorizationUserPolicy(ctx, resource)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setOpenidClientAuthorizationUserPolicyResourceData(data, resource)
 
-	return resourceKeycloakOpenidClientAuthorizationUserPolicyRead(data, meta)
+	return resourceKeycloakOpenidClientAuthorizationUserPolicyRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientAuthorizationUserPolicyRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationUserPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	resourceServerId := data.Get("resource_server_id").(string)
 	id := data.Id()
 
-	resource, err := keycloakClient.GetOpenidClientAuthorizationUserPolicy(realmId, resourceServerId, id)
+	resource, err := keycloakClient.GetOpenidClientAuthorizationUserPolicy(ctx, realmId, resourceServerId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setOpenidClientAuthorizationUserPolicyResourceData(data, resource)
@@ -116,14 +120,14 @@ func resourceKeycloakOpenidClientAuthorizationUserPolicyRead(data *schema.Resour
 	return nil
 }
 
-func resourceKeycloakOpenidClientAuthorizationUserPolicyUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationUserPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	resource := getOpenidClientAuthorizationUserPolicyResourceFromData(data)
 
-	err := keycloakClient.UpdateOpenidClientAuthorizationUserPolicy(resource)
+	err := keycloakClient.UpdateOpenidClientAuthorizationUserPolicy(ctx, resource)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setOpenidClientAuthorizationUserPolicyResourceData(data, resource)
@@ -131,12 +135,16 @@ func resourceKeycloakOpenidClientAuthorizationUserPolicyUpdate(data *schema.Reso
 	return nil
 }
 
-func resourceKeycloakOpenidClientAuthorizationUserPolicyDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientAuthorizationUserPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	resourceServerId := data.Get("resource_server_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteOpenidClientAuthorizationUserPolicy(realmId, resourceServerId, id)
+	if err := keycloakClient.DeleteOpenidClientAuthorizationUserPolicy(ctx, realmId, resourceServerId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
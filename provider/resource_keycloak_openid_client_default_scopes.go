@@ -1,21 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakOpenidClientDefaultScopes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenidClientDefaultScopesCreate,
-		Read:   resourceKeycloakOpenidClientDefaultScopesRead,
-		Delete: resourceKeycloakOpenidClientDefaultScopesDelete,
-		Update: resourceKeycloakOpenidClientDefaultScopesUpdate,
+		CreateContext: resourceKeycloakOpenidClientDefaultScopesCreate,
+		ReadContext:   resourceKeycloakOpenidClientDefaultScopesRead,
+		DeleteContext: resourceKeycloakOpenidClientDefaultScopesDelete,
+		UpdateContext: resourceKeycloakOpenidClientDefaultScopesUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakOpenidClientDefaultScopesImport,
+			StateContext: resourceKeycloakOpenidClientDefaultScopesImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -38,35 +40,35 @@ func resourceKeycloakOpenidClientDefaultScopes() *schema.Resource {
 	}
 }
 
-func resourceKeycloakOpenidClientDefaultScopesCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientDefaultScopesCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	defaultScopes := data.Get("default_scopes").(*schema.Set)
 
-	err := keycloakClient.AttachOpenidClientDefaultScopes(realmId, clientId, interfaceSliceToStringSlice(defaultScopes.List()))
+	err := keycloakClient.AttachOpenidClientDefaultScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(defaultScopes.List()))
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
 	data.SetId(openidClientDefaultScopesId(realmId, clientId))
 
-	return resourceKeycloakOpenidClientDefaultScopesRead(data, meta)
+	return resourceKeycloakOpenidClientDefaultScopesRead(ctx, data, meta)
 }
 
 func openidClientDefaultScopesId(realmId string, clientId string) string {
 	return fmt.Sprintf("%s/%s", realmId, clientId)
 }
 
-func resourceKeycloakOpenidClientDefaultScopesRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientDefaultScopesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 
-	clientScopes, err := keycloakClient.GetOpenidClientDefaultScopes(realmId, clientId)
+	clientScopes, err := keycloakClient.GetOpenidClientDefaultScopes(ctx, realmId, clientId)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	var defaultScopes []string
@@ -80,16 +82,16 @@ func resourceKeycloakOpenidClientDefaultScopesRead(data *schema.ResourceData, me
 	return nil
 }
 
-func resourceKeycloakOpenidClientDefaultScopesUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientDefaultScopesUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	tfOpenidClientDefaultScopes := data.Get("default_scopes").(*schema.Set)
 
-	keycloakOpenidClientDefaultScopes, err := keycloakClient.GetOpenidClientDefaultScopes(realmId, clientId)
+	keycloakOpenidClientDefaultScopes, err := keycloakClient.GetOpenidClientDefaultScopes(ctx, realmId, clientId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var openidClientDefaultScopesToDetach []string
@@ -105,33 +107,37 @@ func resourceKeycloakOpenidClientDefaultScopesUpdate(data *schema.ResourceData,
 	}
 
 	// detach scopes that aren't in tf state
-	err = keycloakClient.DetachOpenidClientDefaultScopes(realmId, clientId, openidClientDefaultScopesToDetach)
+	err = keycloakClient.DetachOpenidClientDefaultScopes(ctx, realmId, clientId, openidClientDefaultScopesToDetach)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// attach scopes that exist in tf state but not in keycloak
-	err = keycloakClient.AttachOpenidClientDefaultScopes(realmId, clientId, interfaceSliceToStringSlice(tfOpenidClientDefaultScopes.List()))
+	err = keycloakClient.AttachOpenidClientDefaultScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(tfOpenidClientDefaultScopes.List()))
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
 	data.SetId(openidClientDefaultScopesId(realmId, clientId))
 
-	return resourceKeycloakOpenidClientDefaultScopesRead(data, meta)
+	return resourceKeycloakOpenidClientDefaultScopesRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientDefaultScopesDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientDefaultScopesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	defaultScopes := data.Get("default_scopes").(*schema.Set)
 
-	return keycloakClient.DetachOpenidClientDefaultScopes(realmId, clientId, interfaceSliceToStringSlice(defaultScopes.List()))
+	if err := keycloakClient.DetachOpenidClientDefaultScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(defaultScopes.List())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakOpenidClientDefaultScopesImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakOpenidClientDefaultScopesImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 2 {
@@ -1,21 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakOpenidClientOptionalScopes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenidClientOptionalScopesCreate,
-		Read:   resourceKeycloakOpenidClientOptionalScopesRead,
-		Delete: resourceKeycloakOpenidClientOptionalScopesDelete,
-		Update: resourceKeycloakOpenidClientOptionalScopesUpdate,
+		CreateContext: resourceKeycloakOpenidClientOptionalScopesCreate,
+		ReadContext:   resourceKeycloakOpenidClientOptionalScopesRead,
+		DeleteContext: resourceKeycloakOpenidClientOptionalScopesDelete,
+		UpdateContext: resourceKeycloakOpenidClientOptionalScopesUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakOpenidClientOptionalScopesImport,
+			StateContext: resourceKeycloakOpenidClientOptionalScopesImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -38,35 +40,35 @@ func resourceKeycloakOpenidClientOptionalScopes() *schema.Resource {
 	}
 }
 
-func resourceKeycloakOpenidClientOptionalScopesCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientOptionalScopesCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	optionalScopes := data.Get("optional_scopes").(*schema.Set)
 
-	err := keycloakClient.AttachOpenidClientOptionalScopes(realmId, clientId, interfaceSliceToStringSlice(optionalScopes.List()))
+	err := keycloakClient.AttachOpenidClientOptionalScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(optionalScopes.List()))
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
 	data.SetId(openidClientOptionalScopesId(realmId, clientId))
 
-	return resourceKeycloakOpenidClientOptionalScopesRead(data, meta)
+	return resourceKeycloakOpenidClientOptionalScopesRead(ctx, data, meta)
 }
 
 func openidClientOptionalScopesId(realmId string, clientId string) string {
 	return fmt.Sprintf("%s/%s", realmId, clientId)
 }
 
-func resourceKeycloakOpenidClientOptionalScopesRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientOptionalScopesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 
-	clientScopes, err := keycloakClient.GetOpenidClientOptionalScopes(realmId, clientId)
+	clientScopes, err := keycloakClient.GetOpenidClientOptionalScopes(ctx, realmId, clientId)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	var optionalScopes []string
@@ -80,16 +82,16 @@ func resourceKeycloakOpenidClientOptionalScopesRead(data *schema.ResourceData, m
 	return nil
 }
 
-func resourceKeycloakOpenidClientOptionalScopesUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientOptionalScopesUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	tfOpenidClientOptionalScopes := data.Get("optional_scopes").(*schema.Set)
 
-	keycloakOpenidClientOptionalScopes, err := keycloakClient.GetOpenidClientOptionalScopes(realmId, clientId)
+	keycloakOpenidClientOptionalScopes, err := keycloakClient.GetOpenidClientOptionalScopes(ctx, realmId, clientId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var openidClientOptionalScopesToDetach []string
@@ -105,33 +107,37 @@ func resourceKeycloakOpenidClientOptionalScopesUpdate(data *schema.ResourceData,
 	}
 
 	// detach scopes that aren't in tf state
-	err = keycloakClient.DetachOpenidClientOptionalScopes(realmId, clientId, openidClientOptionalScopesToDetach)
+	err = keycloakClient.DetachOpenidClientOptionalScopes(ctx, realmId, clientId, openidClientOptionalScopesToDetach)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// attach scopes that exist in tf state but not in keycloak
-	err = keycloakClient.AttachOpenidClientOptionalScopes(realmId, clientId, interfaceSliceToStringSlice(tfOpenidClientOptionalScopes.List()))
+	err = keycloakClient.AttachOpenidClientOptionalScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(tfOpenidClientOptionalScopes.List()))
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
 	data.SetId(openidClientOptionalScopesId(realmId, clientId))
 
-	return resourceKeycloakOpenidClientOptionalScopesRead(data, meta)
+	return resourceKeycloakOpenidClientOptionalScopesRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientOptionalScopesDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientOptionalScopesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	optionalScopes := data.Get("optional_scopes").(*schema.Set)
 
-	return keycloakClient.DetachOpenidClientOptionalScopes(realmId, clientId, interfaceSliceToStringSlice(optionalScopes.List()))
+	if err := keycloakClient.DetachOpenidClientOptionalScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(optionalScopes.List())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakOpenidClientOptionalScopesImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakOpenidClientOptionalScopesImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 2 {
@@ -162,13 +162,13 @@ func TestAccKeycloakOpenidClientOptionalScopes_authoritativeAdd(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					client, err := keycloakClient.GetOpenidClientByClientId(testAccRealm.Realm, client)
+					client, err := keycloakClient.GetOpenidClientByClientId(testCtx, testAccRealm.Realm, client)
 					if err != nil {
 						t.Fatal(err)
 					}
 
 					clientToManuallyDetach := clientScopes[acctest.RandIntRange(0, len(clientScopes)-1)]
-					err = keycloakClient.DetachOpenidClientOptionalScopes(testAccRealm.Realm, client.Id, []string{clientToManuallyDetach})
+					err = keycloakClient.DetachOpenidClientOptionalScopes(testCtx, testAccRealm.Realm, client.Id, []string{clientToManuallyDetach})
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -210,12 +210,12 @@ func TestAccKeycloakOpenidClientOptionalScopes_authoritativeRemove(t *testing.T)
 			},
 			{
 				PreConfig: func() {
-					client, err := keycloakClient.GetOpenidClientByClientId(testAccRealm.Realm, client)
+					client, err := keycloakClient.GetOpenidClientByClientId(testCtx, testAccRealm.Realm, client)
 					if err != nil {
 						t.Fatal(err)
 					}
 
-					err = keycloakClient.AttachOpenidClientOptionalScopes(testAccRealm.Realm, client.Id, []string{clientToManuallyAttach})
+					err = keycloakClient.AttachOpenidClientOptionalScopes(testCtx, testAccRealm.Realm, client.Id, []string{clientToManuallyAttach})
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -248,12 +248,12 @@ func TestAccKeycloakOpenidClientOptionalScopes_noImportNeeded(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					openidClient, err := keycloakClient.GetOpenidClientByClientId(testAccRealm.Realm, client)
+					openidClient, err := keycloakClient.GetOpenidClientByClientId(testCtx, testAccRealm.Realm, client)
 					if err != nil {
 						t.Fatal(err)
 					}
 
-					err = keycloakClient.AttachOpenidClientOptionalScopes(testAccRealm.Realm, openidClient.Id, clientScopes)
+					err = keycloakClient.AttachOpenidClientOptionalScopes(testCtx, testAccRealm.Realm, openidClient.Id, clientScopes)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -330,7 +330,7 @@ func getOptionalClientScopesFromState(resourceName string, s *terraform.State) (
 		client = rs.Primary.ID
 	}
 
-	keycloakOptionalClientScopes, err := keycloakClient.GetOpenidClientOptionalScopes(realm, client)
+	keycloakOptionalClientScopes, err := keycloakClient.GetOpenidClientOptionalScopes(testCtx, realm, client)
 	if err != nil {
 		return nil, err
 	}
@@ -1,23 +1,25 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakOpenidClientPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenidClientPermissionsCreate,
-		Read:   resourceKeycloakOpenidClientPermissionsRead,
-		Delete: resourceKeycloakOpenidClientPermissionsDelete,
-		Update: resourceKeycloakOpenidClientPermissionsUpdate,
+		CreateContext: resourceKeycloakOpenidClientPermissionsCreate,
+		ReadContext:   resourceKeycloakOpenidClientPermissionsRead,
+		DeleteContext: resourceKeycloakOpenidClientPermissionsDelete,
+		UpdateContext: resourceKeycloakOpenidClientPermissionsUpdate,
 		// This resource can be imported using {{realm}}/{{client_id}}. The Client ID is displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakOpenidClientPermissionsImport,
+			StateContext: resourceKeycloakOpenidClientPermissionsImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -56,23 +58,23 @@ func clientPermissionsId(realmId, clientId string) string {
 
 // TODO: is this needed?
 //func unsetOpenidClientScopePermissionPolicy(keycloakClient *keycloak.KeycloakClient, realmId, clientId, scopeName string) error {
-//	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(realmId, clientId)
+//	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(ctx, realmId, clientId)
 //	if err != nil {
 //		return err
 //	}
 //
-//	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(realmId, "realm-management")
+//	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
 //	if err != nil {
 //		return err
 //	}
 //
-//	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions[scopeName].(string))
+//	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions[scopeName].(string))
 //	if err != nil {
 //		return err
 //	}
 //
 //	permission.Policies = []string{}
-//	err = keycloakClient.UpdateOpenidClientAuthorizationPermission(permission)
+//	err = keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
 //	if err != nil {
 //		return err
 //	}
@@ -80,86 +82,86 @@ func clientPermissionsId(realmId, clientId string) string {
 //	return nil
 //}
 
-func resourceKeycloakOpenidClientPermissionsCreate(data *schema.ResourceData, meta interface{}) error {
-	return resourceKeycloakOpenidClientPermissionsUpdate(data, meta)
+func resourceKeycloakOpenidClientPermissionsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceKeycloakOpenidClientPermissionsUpdate(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientPermissionsUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientPermissionsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 
 	// the existence of this resource implies that permissions are enabled for this client.
-	err := keycloakClient.EnableOpenidClientPermissions(realmId, clientId)
+	err := keycloakClient.EnableOpenidClientPermissions(ctx, realmId, clientId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(realmId, clientId)
+	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(ctx, realmId, clientId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(realmId, "realm-management")
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if viewScope, ok := data.GetOk("view_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["view"].(string), viewScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["view"].(string), viewScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if manageScope, ok := data.GetOk("manage_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["manage"].(string), manageScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["manage"].(string), manageScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if configureScope, ok := data.GetOk("configure_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["configure"].(string), configureScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["configure"].(string), configureScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if mapRolesScope, ok := data.GetOk("map_roles_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles"].(string), mapRolesScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles"].(string), mapRolesScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if mapRolesClientsScope, ok := data.GetOk("map_roles_client_scope_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-client-scope"].(string), mapRolesClientsScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-client-scope"].(string), mapRolesClientsScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if mapRolesCompositeScope, ok := data.GetOk("map_roles_composite_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-composite"].(string), mapRolesCompositeScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-composite"].(string), mapRolesCompositeScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if tokenExchangeScope, ok := data.GetOk("token_exchange_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["token-exchange"].(string), tokenExchangeScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["token-exchange"].(string), tokenExchangeScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceKeycloakOpenidClientPermissionsRead(data, meta)
+	return resourceKeycloakOpenidClientPermissionsRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientPermissionsRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientPermissionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 
-	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(realmId, clientId)
+	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(ctx, realmId, clientId)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	if !openidClientPermissions.Enabled {
@@ -168,9 +170,9 @@ func resourceKeycloakOpenidClientPermissionsRead(data *schema.ResourceData, meta
 		return nil
 	}
 
-	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(realmId, "realm-management")
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	data.SetId(clientPermissionsId(openidClientPermissions.RealmId, openidClientPermissions.ClientId))
@@ -179,61 +181,65 @@ func resourceKeycloakOpenidClientPermissionsRead(data *schema.ResourceData, meta
 	data.Set("enabled", openidClientPermissions.Enabled)
 	data.Set("authorization_resource_server_id", realmManagementClient.Id)
 
-	if viewScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["view"].(string)); err == nil && viewScope != nil {
+	if viewScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["view"].(string)); err == nil && viewScope != nil {
 		data.Set("view_scope", []interface{}{viewScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if manageScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["manage"].(string)); err == nil && manageScope != nil {
+	if manageScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["manage"].(string)); err == nil && manageScope != nil {
 		data.Set("manage_scope", []interface{}{manageScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if mapRolesScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["configure"].(string)); err == nil && mapRolesScope != nil {
+	if mapRolesScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["configure"].(string)); err == nil && mapRolesScope != nil {
 		data.Set("configure_scope", []interface{}{mapRolesScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if manageGroupMembershipScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles"].(string)); err == nil && manageGroupMembershipScope != nil {
+	if manageGroupMembershipScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles"].(string)); err == nil && manageGroupMembershipScope != nil {
 		data.Set("map_roles_scope", []interface{}{manageGroupMembershipScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if impersonateScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-client-scope"].(string)); err == nil && impersonateScope != nil {
+	if impersonateScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-client-scope"].(string)); err == nil && impersonateScope != nil {
 		data.Set("map_roles_client_scope_scope", []interface{}{impersonateScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if userImpersonatedScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-composite"].(string)); err == nil && userImpersonatedScope != nil {
+	if userImpersonatedScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-composite"].(string)); err == nil && userImpersonatedScope != nil {
 		data.Set("map_roles_composite_scope", []interface{}{userImpersonatedScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if tokenExchangeScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["token-exchange"].(string)); err == nil && tokenExchangeScope != nil {
+	if tokenExchangeScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["token-exchange"].(string)); err == nil && tokenExchangeScope != nil {
 		data.Set("token_exchange_scope", []interface{}{tokenExchangeScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceKeycloakOpenidClientPermissionsDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientPermissionsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 
-	return keycloakClient.DisableOpenidClientPermissions(realmId, clientId)
+	if err := keycloakClient.DisableOpenidClientPermissions(ctx, realmId, clientId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakOpenidClientPermissionsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakOpenidClientPermissionsImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{openidClientId}}")
@@ -1,25 +1,27 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakOpenidClientScope() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenidClientScopeCreate,
-		Read:   resourceKeycloakOpenidClientScopeRead,
-		Delete: resourceKeycloakOpenidClientScopeDelete,
-		Update: resourceKeycloakOpenidClientScopeUpdate,
+		CreateContext: resourceKeycloakOpenidClientScopeCreate,
+		ReadContext:   resourceKeycloakOpenidClientScopeRead,
+		DeleteContext: resourceKeycloakOpenidClientScopeDelete,
+		UpdateContext: resourceKeycloakOpenidClientScopeUpdate,
 		// This resource can be imported using {{realm}}/{{client_scope_id}}. The Client Scope ID is displayed in the GUI
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakOpenidClientScopeImport,
+			StateContext: resourceKeycloakOpenidClientScopeImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -100,49 +102,43 @@ func setClientScopeData(data *schema.ResourceData, clientScope *keycloak.OpenidC
 	}
 }
 
-func resourceKeycloakOpenidClientScopeCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientScopeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	clientScope := getClientScopeFromData(data)
 
-	err := keycloakClient.NewOpenidClientScope(clientScope)
+	err := keycloakClient.NewOpenidClientScope(ctx, clientScope)
 	if err != nil {
-		var ae *keycloak.ApiError
-		if !errors.As(err, &ae) {
-			return err
-		}
-
-		if ae.Code != 409 {
-			return err
+		if !keycloak.ErrorIs409(err) {
+			return diag.FromErr(err)
 		}
 
 		log.Println("resource already exists, may be hardcoded, try to update")
-		sc, err1 := keycloakClient.GetOpenIdClientScopeByName(clientScope.RealmId, clientScope.Name)
+		sc, err1 := keycloakClient.GetOpenIdClientScopeByName(ctx, clientScope.RealmId, clientScope.Name)
 		if err1 != nil {
-			return err1
+			return diag.FromErr(err1)
 		}
 		data.SetId(sc.Id)
 		clientScope.Id = sc.Id
-		err = resourceKeycloakOpenidClientScopeUpdate(data, meta)
-		if err != nil {
-			return err
+		if diags := resourceKeycloakOpenidClientScopeUpdate(ctx, data, meta); diags.HasError() {
+			return diags
 		}
 	}
 
 	setClientScopeData(data, clientScope)
 
-	return resourceKeycloakOpenidClientScopeRead(data, meta)
+	return resourceKeycloakOpenidClientScopeRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenidClientScopeRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientScopeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	clientScope, err := keycloakClient.GetOpenidClientScope(realmId, id)
+	clientScope, err := keycloakClient.GetOpenidClientScope(ctx, realmId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	setClientScopeData(data, clientScope)
@@ -150,14 +146,14 @@ func resourceKeycloakOpenidClientScopeRead(data *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceKeycloakOpenidClientScopeUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientScopeUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	clientScope := getClientScopeFromData(data)
 
-	err := keycloakClient.UpdateOpenidClientScope(clientScope)
+	err := keycloakClient.UpdateOpenidClientScope(ctx, clientScope)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setClientScopeData(data, clientScope)
@@ -165,25 +161,25 @@ func resourceKeycloakOpenidClientScopeUpdate(data *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceKeycloakOpenidClientScopeDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenidClientScopeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	err := keycloakClient.DeleteOpenidClientScope(realmId, id)
+	err := keycloakClient.DeleteOpenidClientScope(ctx, realmId, id)
 	if err != nil {
 		var ae *keycloak.ApiError
 		if !errors.As(err, &ae) {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if ae.Code != 400 {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if !strings.Contains(ae.Message, "Cannot remove client scope, it is currently in use") {
-			return err
+			return diag.FromErr(err)
 		}
 
 		log.Println("resource cannot be deleted, ignore error (probably built-in resource and/or still referenced by another resource)")
@@ -191,7 +187,7 @@ func resourceKeycloakOpenidClientScopeDelete(data *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceKeycloakOpenidClientScopeImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakOpenidClientScopeImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{openidClientScopeId}}")
@@ -71,7 +71,7 @@ func TestAccKeycloakClientScope_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteOpenidClientScope(clientScope.RealmId, clientScope.Id)
+					err := keycloakClient.DeleteOpenidClientScope(testCtx, clientScope.RealmId, clientScope.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -286,7 +286,7 @@ func testAccCheckKeycloakClientScopeDestroy() resource.TestCheckFunc {
 			id := rs.Primary.ID
 			realm := rs.Primary.Attributes["realm_id"]
 
-			clientScope, _ := keycloakClient.GetOpenidClientScope(realm, id)
+			clientScope, _ := keycloakClient.GetOpenidClientScope(testCtx, realm, id)
 			if clientScope != nil {
 				return fmt.Errorf("openid client scope %s still exists", id)
 			}
@@ -307,7 +307,7 @@ func getClientScopeFromState(s *terraform.State, resourceName string) (*keycloak
 	id := rs.Primary.ID
 	realm := rs.Primary.Attributes["realm_id"]
 
-	clientScope, err := keycloakClientScope.GetOpenidClientScope(realm, id)
+	clientScope, err := keycloakClientScope.GetOpenidClientScope(testCtx, realm, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting openid client scope %s: %s", id, err)
 	}
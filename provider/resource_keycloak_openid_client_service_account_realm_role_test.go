@@ -52,7 +52,7 @@ func TestAccKeycloakOpenidClientServiceAccountRealmRole_createAfterManualDestroy
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteOpenidClientServiceAccountRealmRole(serviceAccountRole.RealmId, serviceAccountRole.ServiceAccountUserId, serviceAccountRole.Id)
+					err := keycloakClient.DeleteOpenidClientServiceAccountRealmRole(testCtx, serviceAccountRole.RealmId, serviceAccountRole.ServiceAccountUserId, serviceAccountRole.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -101,7 +101,7 @@ func testAccCheckKeycloakOpenidClientServiceAccountRealmRoleDestroy() resource.T
 			serviceAccountUserId := rs.Primary.Attributes["service_account_user_id"]
 			id := strings.Split(rs.Primary.ID, "/")[1]
 
-			serviceAccountRole, _ := keycloakClient.GetOpenidClientServiceAccountRealmRole(realm, serviceAccountUserId, id)
+			serviceAccountRole, _ := keycloakClient.GetOpenidClientServiceAccountRealmRole(testCtx, realm, serviceAccountUserId, id)
 			if serviceAccountRole != nil {
 				return fmt.Errorf("service account role exists")
 			}
@@ -121,7 +121,7 @@ func getKeycloakOpenidClientServiceAccountRealmRoleFromState(s *terraform.State,
 	serviceAccountUserId := rs.Primary.Attributes["service_account_user_id"]
 	id := strings.Split(rs.Primary.ID, "/")[1]
 
-	serviceAccountRole, err := keycloakClient.GetOpenidClientServiceAccountRealmRole(realm, serviceAccountUserId, id)
+	serviceAccountRole, err := keycloakClient.GetOpenidClientServiceAccountRealmRole(testCtx, realm, serviceAccountUserId, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting service account role mapping: %s", err)
 	}
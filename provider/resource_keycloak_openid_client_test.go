@@ -72,7 +72,7 @@ func TestAccKeycloakOpenidClient_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteOpenidClient(client.RealmId, client.Id)
+					err := keycloakClient.DeleteOpenidClient(testCtx, client.RealmId, client.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -741,7 +741,7 @@ func testAccCheckKeycloakOpenidClientDestroy() resource.TestCheckFunc {
 			id := rs.Primary.ID
 			realm := rs.Primary.Attributes["realm_id"]
 
-			client, _ := keycloakClient.GetOpenidClient(realm, id)
+			client, _ := keycloakClient.GetOpenidClient(testCtx, realm, id)
 			if client != nil {
 				return fmt.Errorf("openid client %s still exists", id)
 			}
@@ -855,7 +855,7 @@ func getOpenidClientFromState(s *terraform.State, resourceName string) (*keycloa
 	id := rs.Primary.ID
 	realm := rs.Primary.Attributes["realm_id"]
 
-	client, err := keycloakClient.GetOpenidClient(realm, id)
+	client, err := keycloakClient.GetOpenidClient(testCtx, realm, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting openid client %s: %s", id, err)
 	}
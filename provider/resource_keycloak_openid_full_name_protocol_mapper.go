@@ -1,22 +1,25 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakOpenIdFullNameProtocolMapper() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakOpenIdFullNameProtocolMapperCreate,
-		Read:   resourceKeycloakOpenIdFullNameProtocolMapperRead,
-		Update: resourceKeycloakOpenIdFullNameProtocolMapperUpdate,
-		Delete: resourceKeycloakOpenIdFullNameProtocolMapperDelete,
+		CreateContext: resourceKeycloakOpenIdFullNameProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdFullNameProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdFullNameProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdFullNameProtocolMapperDelete,
 		Importer: &schema.ResourceImporter{
 			// import a mapper tied to a client:
 			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
 			// or a client scope:
 			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
-			State: genericProtocolMapperImport,
+			StateContext: genericProtocolMapperImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -94,36 +97,36 @@ func mapFromOpenIdFullNameMapperToData(mapper *keycloak.OpenIdFullNameProtocolMa
 	data.Set("add_to_userinfo", mapper.AddToUserInfo)
 }
 
-func resourceKeycloakOpenIdFullNameProtocolMapperCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenIdFullNameProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	openIdFullNameMapper := mapFromDataToOpenIdFullNameProtocolMapper(data)
 
-	err := keycloakClient.ValidateOpenIdFullNameProtocolMapper(openIdFullNameMapper)
+	err := keycloakClient.ValidateOpenIdFullNameProtocolMapper(ctx, openIdFullNameMapper)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.NewOpenIdFullNameProtocolMapper(openIdFullNameMapper)
+	err = keycloakClient.NewOpenIdFullNameProtocolMapper(ctx, openIdFullNameMapper)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mapFromOpenIdFullNameMapperToData(openIdFullNameMapper, data)
 
-	return resourceKeycloakOpenIdFullNameProtocolMapperRead(data, meta)
+	return resourceKeycloakOpenIdFullNameProtocolMapperRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenIdFullNameProtocolMapperRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenIdFullNameProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	clientScopeId := data.Get("client_scope_id").(string)
 
-	openIdFullNameMapper, err := keycloakClient.GetOpenIdFullNameProtocolMapper(realmId, clientId, clientScopeId, data.Id())
+	openIdFullNameMapper, err := keycloakClient.GetOpenIdFullNameProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	mapFromOpenIdFullNameMapperToData(openIdFullNameMapper, data)
@@ -131,30 +134,34 @@ func resourceKeycloakOpenIdFullNameProtocolMapperRead(data *schema.ResourceData,
 	return nil
 }
 
-func resourceKeycloakOpenIdFullNameProtocolMapperUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenIdFullNameProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	openIdFullNameMapper := mapFromDataToOpenIdFullNameProtocolMapper(data)
 
-	err := keycloakClient.ValidateOpenIdFullNameProtocolMapper(openIdFullNameMapper)
+	err := keycloakClient.ValidateOpenIdFullNameProtocolMapper(ctx, openIdFullNameMapper)
 	if err != nil {
-		return err
+		return handleValidationError(err, nil)
 	}
 
-	err = keycloakClient.UpdateOpenIdFullNameProtocolMapper(openIdFullNameMapper)
+	err = keycloakClient.UpdateOpenIdFullNameProtocolMapper(ctx, openIdFullNameMapper)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceKeycloakOpenIdFullNameProtocolMapperRead(data, meta)
+	return resourceKeycloakOpenIdFullNameProtocolMapperRead(ctx, data, meta)
 }
 
-func resourceKeycloakOpenIdFullNameProtocolMapperDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakOpenIdFullNameProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	clientScopeId := data.Get("client_scope_id").(string)
 
-	return keycloakClient.DeleteOpenIdFullNameProtocolMapper(realmId, clientId, clientScopeId, data.Id())
+	if err := keycloakClient.DeleteOpenIdFullNameProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
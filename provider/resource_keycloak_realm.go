@@ -1,13 +1,24 @@
 package provider
 
 import (
-	"fmt"
+	"context"
+	"strconv"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
+// Validation errors for fields that don't share their name with an attribute
+var realmValidationAttributePaths = map[string]cty.Path{
+	"DefaultLocale": cty.GetAttrPath("internationalization").IndexInt(0).GetAttr("default_locale"),
+}
+
+// Keycloak keeps the lifespan of pushed authorization requests in a realm attribute rather than a field
+const realmParRequestUriLifespanAttribute = "parRequestUriLifespan"
+
 func resourceKeycloakRealm() *schema.Resource {
 	webAuthnSchema := map[string]*schema.Schema{
 		"acceptable_aaguids": {
@@ -86,13 +97,15 @@ func resourceKeycloakRealm() *schema.Resource {
 		},
 	}
 	return &schema.Resource{
-		Create: resourceKeycloakRealmCreate,
-		Read:   resourceKeycloakRealmRead,
-		Delete: resourceKeycloakRealmDelete,
-		Update: resourceKeycloakRealmUpdate,
+		CreateContext: resourceKeycloakRealmCreate,
+		ReadContext:   resourceKeycloakRealmRead,
+		DeleteContext: resourceKeycloakRealmDelete,
+		UpdateContext: resourceKeycloakRealmUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts:      slowResourceTimeouts(),
+		CustomizeDiff: validateRealmPasswordPolicy,
 		Schema: map[string]*schema.Schema{
 			"realm": {
 				Type:     schema.TypeString,
@@ -121,6 +134,16 @@ func resourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"organizations_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"admin_permissions_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 
 			// Login Config
 			"registration_allowed": {
@@ -477,9 +500,92 @@ func resourceKeycloakRealm() *schema.Resource {
 
 			// authentication password policy
 			"password_policy": {
-				Type:        schema.TypeString,
-				Description: "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
-				Optional:    true,
+				Type:             schema.TypeString,
+				Description:      "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
+				Optional:         true,
+				ConflictsWith:    []string{"password_policy_settings"},
+				DiffSuppressFunc: suppressPasswordPolicyDiff,
+			},
+			"password_policy_settings": {
+				Type:          schema.TypeList,
+				Description:   "The password policy as individual settings, as an alternative to password_policy",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"password_policy"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"length": {
+							Type:         schema.TypeInt,
+							Description:  "The minimum length of passwords",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"digits": {
+							Type:         schema.TypeInt,
+							Description:  "The minimum number of digits in passwords",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"upper_case": {
+							Type:         schema.TypeInt,
+							Description:  "The minimum number of upper case letters in passwords",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"lower_case": {
+							Type:         schema.TypeInt,
+							Description:  "The minimum number of lower case letters in passwords",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"special_chars": {
+							Type:         schema.TypeInt,
+							Description:  "The minimum number of special characters in passwords",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"not_username": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"not_email": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"password_history": {
+							Type:         schema.TypeInt,
+							Description:  "The number of previous passwords that can't be used again",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"force_expired_password_change": {
+							Type:         schema.TypeInt,
+							Description:  "The number of days after which passwords have to be changed",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"hash_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"hash_iterations": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"regex": {
+							Type:         schema.TypeString,
+							Description:  "A regular expression passwords have to match",
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"blacklist": {
+							Type:        schema.TypeString,
+							Description: "The name of a file with passwords that can't be used, in the password blacklists folder of the server",
+							Optional:    true,
+						},
+					},
+				},
 			},
 
 			// misc attributes
@@ -525,6 +631,149 @@ func resourceKeycloakRealm() *schema.Resource {
 					Schema: webAuthnSchema,
 				},
 			},
+
+			// OTP
+			"otp_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Either totp (time based) or hotp (counter based)",
+							Optional:     true,
+							Default:      "totp",
+							ValidateFunc: validation.StringInSlice([]string{"totp", "hotp"}, false),
+						},
+						"algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "HmacSHA1",
+							ValidateFunc: validation.StringInSlice([]string{"HmacSHA1", "HmacSHA256", "HmacSHA512"}, false),
+						},
+						"digits": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntInSlice([]int{6, 8}),
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Description:  "How many seconds a totp code is valid",
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"look_ahead_window": {
+							Type:         schema.TypeInt,
+							Description:  "How many codes ahead of the current one are accepted, to allow for clocks or counters that are out of sync",
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"initial_counter": {
+							Type:         schema.TypeInt,
+							Description:  "The counter hotp codes start at",
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"code_reusable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"supported_applications": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// CIBA
+			"ciba_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backchannel_token_delivery_mode": {
+							Type:         schema.TypeString,
+							Description:  "Either poll or ping",
+							Optional:     true,
+							Default:      "poll",
+							ValidateFunc: validation.StringInSlice([]string{"poll", "ping"}, false),
+						},
+						"expires_in": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "2m",
+							DiffSuppressFunc: suppressDurationStringDiff,
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Description:  "The minimum number of seconds between polls for a token",
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntBetween(0, 600),
+						},
+						"auth_requested_user_hint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "login_hint",
+							ValidateFunc: validation.StringInSlice([]string{"login_hint"}, false),
+						},
+					},
+				},
+			},
+
+			// OAuth 2.0 Device Authorization Grant
+			"oauth2_device_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_lifespan": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "10m",
+							DiffSuppressFunc: suppressDurationStringDiff,
+						},
+						"polling_interval": {
+							Type:         schema.TypeInt,
+							Description:  "The minimum number of seconds between polls for a token",
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			// Pushed Authorization Requests
+			"par_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_uri_lifespan": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "1m",
+							DiffSuppressFunc: suppressDurationStringDiff,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -590,6 +839,17 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 		DefaultLocale:               defaultLocale,
 	}
 
+	// these are only sent when they are set on creation or changed afterwards, as they are rejected by versions of
+	// Keycloak that don't support them. Keycloak keeps the current value of settings left out of an update.
+	if organizationsEnabled, ok := data.GetOkExists("organizations_enabled"); ok && (data.IsNewResource() || data.HasChange("organizations_enabled")) {
+		enabled := organizationsEnabled.(bool)
+		realm.OrganizationsEnabled = &enabled
+	}
+	if adminPermissionsEnabled, ok := data.GetOkExists("admin_permissions_enabled"); ok && (data.IsNewResource() || data.HasChange("admin_permissions_enabled")) {
+		enabled := adminPermissionsEnabled.(bool)
+		realm.AdminPermissionsEnabled = &enabled
+	}
+
 	//smtp
 	if v, ok := data.GetOk("smtp_server"); ok {
 		smtpSettings := v.([]interface{})[0].(map[string]interface{})
@@ -796,6 +1056,9 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	if passwordPolicy, ok := data.GetOk("password_policy"); ok {
 		realm.PasswordPolicy = passwordPolicy.(string)
 	}
+	if v, ok := data.GetOk("password_policy_settings"); ok {
+		realm.PasswordPolicy = getPasswordPolicyFromSettings(v.([]interface{}))
+	}
 
 	attributes := map[string]interface{}{}
 	if v, ok := data.GetOk("attributes"); ok {
@@ -805,6 +1068,65 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 	}
 	realm.Attributes = attributes
 
+	//OTP
+	if v, ok := data.GetOk("otp_policy"); ok {
+		otpPolicy := v.([]interface{})[0].(map[string]interface{})
+
+		lookAheadWindow := otpPolicy["look_ahead_window"].(int)
+		initialCounter := otpPolicy["initial_counter"].(int)
+		codeReusable := otpPolicy["code_reusable"].(bool)
+
+		realm.OtpPolicyType = otpPolicy["type"].(string)
+		realm.OtpPolicyAlgorithm = otpPolicy["algorithm"].(string)
+		realm.OtpPolicyDigits = otpPolicy["digits"].(int)
+		realm.OtpPolicyPeriod = otpPolicy["period"].(int)
+		realm.OtpPolicyLookAheadWindow = &lookAheadWindow
+		realm.OtpPolicyInitialCounter = &initialCounter
+		realm.OtpPolicyCodeReusable = &codeReusable
+	}
+
+	//CIBA
+	if v, ok := data.GetOk("ciba_policy"); ok {
+		cibaPolicy := v.([]interface{})[0].(map[string]interface{})
+
+		expiresIn, err := getSecondsFromDurationString(cibaPolicy["expires_in"].(string))
+		if err != nil {
+			return nil, err
+		}
+		interval := cibaPolicy["interval"].(int)
+
+		realm.CibaBackchannelTokenDeliveryMode = cibaPolicy["backchannel_token_delivery_mode"].(string)
+		realm.CibaExpiresIn = expiresIn
+		realm.CibaInterval = &interval
+		realm.CibaAuthRequestedUserHint = cibaPolicy["auth_requested_user_hint"].(string)
+	}
+
+	//OAuth 2.0 Device Authorization Grant
+	if v, ok := data.GetOk("oauth2_device_policy"); ok {
+		oauth2DevicePolicy := v.([]interface{})[0].(map[string]interface{})
+
+		codeLifespan, err := getSecondsFromDurationString(oauth2DevicePolicy["code_lifespan"].(string))
+		if err != nil {
+			return nil, err
+		}
+		pollingInterval := oauth2DevicePolicy["polling_interval"].(int)
+
+		realm.OAuth2DeviceCodeLifespan = codeLifespan
+		realm.OAuth2DevicePollingInterval = &pollingInterval
+	}
+
+	//PAR, which Keycloak keeps in a realm attribute
+	if v, ok := data.GetOk("par_policy"); ok {
+		parPolicy := v.([]interface{})[0].(map[string]interface{})
+
+		requestUriLifespan, err := getSecondsFromDurationString(parPolicy["request_uri_lifespan"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		realm.Attributes[realmParRequestUriLifespanAttribute] = strconv.Itoa(requestUriLifespan)
+	}
+
 	defaultDefaultClientScopes := make([]string, 0)
 	if v, ok := data.GetOk("default_default_client_scopes"); ok {
 		for _, defaultDefaultClientScope := range v.(*schema.Set).List() {
@@ -915,6 +1237,13 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	data.Set("display_name", realm.DisplayName)
 	data.Set("display_name_html", realm.DisplayNameHtml)
 	data.Set("user_managed_access", realm.UserManagedAccess)
+	// Keycloak only returns these settings since the versions that introduced them
+	if realm.OrganizationsEnabled != nil {
+		data.Set("organizations_enabled", *realm.OrganizationsEnabled)
+	}
+	if realm.AdminPermissionsEnabled != nil {
+		data.Set("admin_permissions_enabled", *realm.AdminPermissionsEnabled)
+	}
 
 	// Login Config
 	data.Set("registration_allowed", realm.RegistrationAllowed)
@@ -1028,7 +1357,11 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 		}
 	}
 
-	data.Set("password_policy", realm.PasswordPolicy)
+	if _, ok := data.GetOk("password_policy_settings"); ok {
+		data.Set("password_policy_settings", getPasswordPolicySettings(realm.PasswordPolicy))
+	} else {
+		data.Set("password_policy", realm.PasswordPolicy)
+	}
 
 	//WebAuthn
 	webAuthnPolicy := make(map[string]interface{})
@@ -1058,6 +1391,54 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	webAuthnPasswordlessPolicy["user_verification_requirement"] = realm.WebAuthnPolicyPasswordlessUserVerificationRequirement
 	data.Set("web_authn_passwordless_policy", []interface{}{webAuthnPasswordlessPolicy})
 
+	// The policies below are only known when they have been read or configured
+	if realm.OtpPolicyType != "" {
+		otpPolicy := make(map[string]interface{})
+		otpPolicy["type"] = realm.OtpPolicyType
+		otpPolicy["algorithm"] = realm.OtpPolicyAlgorithm
+		otpPolicy["digits"] = realm.OtpPolicyDigits
+		otpPolicy["period"] = realm.OtpPolicyPeriod
+		if realm.OtpPolicyLookAheadWindow != nil {
+			otpPolicy["look_ahead_window"] = *realm.OtpPolicyLookAheadWindow
+		}
+		if realm.OtpPolicyInitialCounter != nil {
+			otpPolicy["initial_counter"] = *realm.OtpPolicyInitialCounter
+		}
+		if realm.OtpPolicyCodeReusable != nil {
+			otpPolicy["code_reusable"] = *realm.OtpPolicyCodeReusable
+		}
+		otpPolicy["supported_applications"] = realm.OtpSupportedApplications
+		data.Set("otp_policy", []interface{}{otpPolicy})
+	}
+
+	if realm.CibaBackchannelTokenDeliveryMode != "" {
+		cibaPolicy := make(map[string]interface{})
+		cibaPolicy["backchannel_token_delivery_mode"] = realm.CibaBackchannelTokenDeliveryMode
+		cibaPolicy["expires_in"] = getDurationStringFromSeconds(realm.CibaExpiresIn)
+		if realm.CibaInterval != nil {
+			cibaPolicy["interval"] = *realm.CibaInterval
+		}
+		cibaPolicy["auth_requested_user_hint"] = realm.CibaAuthRequestedUserHint
+		data.Set("ciba_policy", []interface{}{cibaPolicy})
+	}
+
+	if realm.OAuth2DeviceCodeLifespan != 0 {
+		oauth2DevicePolicy := make(map[string]interface{})
+		oauth2DevicePolicy["code_lifespan"] = getDurationStringFromSeconds(realm.OAuth2DeviceCodeLifespan)
+		if realm.OAuth2DevicePollingInterval != nil {
+			oauth2DevicePolicy["polling_interval"] = *realm.OAuth2DevicePollingInterval
+		}
+		data.Set("oauth2_device_policy", []interface{}{oauth2DevicePolicy})
+	}
+
+	if v, ok := realm.Attributes[realmParRequestUriLifespanAttribute].(string); ok {
+		if requestUriLifespan, err := strconv.Atoi(v); err == nil {
+			data.Set("par_policy", []interface{}{map[string]interface{}{
+				"request_uri_lifespan": getDurationStringFromSeconds(requestUriLifespan),
+			}})
+		}
+	}
+
 	attributes := map[string]interface{}{}
 	if v, ok := data.GetOk("attributes"); ok {
 		for key := range v.(map[string]interface{}) {
@@ -1066,6 +1447,10 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 		}
 	} else {
 		for k, v := range realm.Attributes {
+			// managed with par_policy
+			if k == realmParRequestUriLifespanAttribute {
+				continue
+			}
 			attributes[k] = v
 		}
 	}
@@ -1076,6 +1461,101 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	data.Set("default_optional_client_scopes", realm.DefaultOptionalClientScopes)
 }
 
+// The attributes of password_policy_settings and the password-policy providers they configure, in the order they are
+// sent to Keycloak
+var realmPasswordPolicySettings = []struct {
+	attribute  string
+	policyType string
+	valueType  schema.ValueType
+}{
+	{"length", "length", schema.TypeInt},
+	{"digits", "digits", schema.TypeInt},
+	{"upper_case", "upperCase", schema.TypeInt},
+	{"lower_case", "lowerCase", schema.TypeInt},
+	{"special_chars", "specialChars", schema.TypeInt},
+	{"not_username", "notUsername", schema.TypeBool},
+	{"not_email", "notEmail", schema.TypeBool},
+	{"password_history", "passwordHistory", schema.TypeInt},
+	{"force_expired_password_change", "forceExpiredPasswordChange", schema.TypeInt},
+	{"hash_algorithm", "hashAlgorithm", schema.TypeString},
+	{"hash_iterations", "hashIterations", schema.TypeInt},
+	{"regex", "regexPattern", schema.TypeString},
+	{"blacklist", "passwordBlacklist", schema.TypeString},
+}
+
+// Settings that are zero, false or empty aren't part of the policy
+func getPasswordPolicyFromSettings(v []interface{}) string {
+	if len(v) == 0 || v[0] == nil {
+		return ""
+	}
+	settings := v[0].(map[string]interface{})
+
+	var terms []keycloak.PasswordPolicyTerm
+	for _, setting := range realmPasswordPolicySettings {
+		switch value := settings[setting.attribute].(type) {
+		case int:
+			if value != 0 {
+				terms = append(terms, keycloak.PasswordPolicyTerm{Type: setting.policyType, Value: strconv.Itoa(value)})
+			}
+		case bool:
+			if value {
+				terms = append(terms, keycloak.PasswordPolicyTerm{Type: setting.policyType})
+			}
+		case string:
+			if value != "" {
+				terms = append(terms, keycloak.PasswordPolicyTerm{Type: setting.policyType, Value: value})
+			}
+		}
+	}
+
+	return keycloak.FormatPasswordPolicy(terms)
+}
+
+func getPasswordPolicySettings(passwordPolicy string) []interface{} {
+	terms := map[string]string{}
+	for _, term := range keycloak.ParsePasswordPolicy(passwordPolicy) {
+		terms[term.Type] = term.Value
+	}
+
+	settings := map[string]interface{}{}
+	for _, setting := range realmPasswordPolicySettings {
+		value, ok := terms[setting.policyType]
+
+		switch setting.valueType {
+		case schema.TypeInt:
+			settings[setting.attribute], _ = strconv.Atoi(value)
+		case schema.TypeBool:
+			settings[setting.attribute] = ok
+		default:
+			settings[setting.attribute] = value
+		}
+	}
+
+	return []interface{}{settings}
+}
+
+// Keycloak may return the terms of a password policy in a different order than they were sent in
+func suppressPasswordPolicyDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return keycloak.PasswordPoliciesEqual(old, new)
+}
+
+// Checks the password policy against the password-policy providers installed on the server when planning, rather than
+// only when it's applied
+func validateRealmPasswordPolicy(ctx context.Context, data *schema.ResourceDiff, meta interface{}) error {
+	if !data.HasChange("password_policy") && !data.HasChange("password_policy_settings") {
+		return nil
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	passwordPolicy := data.Get("password_policy").(string)
+	if v, ok := data.GetOk("password_policy_settings"); ok {
+		passwordPolicy = getPasswordPolicyFromSettings(v.([]interface{}))
+	}
+
+	return keycloakClient.ValidatePasswordPolicy(ctx, passwordPolicy)
+}
+
 func getBruteForceDetectionSettings(realm *keycloak.Realm) map[string]interface{} {
 	bruteForceDetectionSettings := make(map[string]interface{})
 	bruteForceDetectionSettings["permanent_lockout"] = realm.PermanentLockout
@@ -1114,43 +1594,43 @@ func getHeaderSettings(realm *keycloak.Realm) map[string]interface{} {
 	return headersSettings
 }
 
-func resourceKeycloakRealmCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakRealmCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realm, err := getRealmFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.ValidateRealm(realm)
+	err = keycloakClient.ValidateRealm(ctx, realm)
 	if err != nil {
-		return err
+		return handleValidationError(err, realmValidationAttributePaths)
 	}
 
-	err = keycloakClient.NewRealm(realm)
+	err = keycloakClient.NewRealm(ctx, realm)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	comps, err := keycloakClient.GetComponents(realm.Realm, realm.Realm, "org.keycloak.keys.KeyProvider")
+	comps, err := keycloakClient.GetComponents(ctx, realm.Realm, realm.Realm, "org.keycloak.keys.KeyProvider")
 	if err != nil {
-		return fmt.Errorf("Error while removing hardcoded keyproviders during realm creation. %v", err)
+		return diag.Errorf("Error while removing hardcoded keyproviders during realm creation. %v", err)
 	}
 	for _, comp := range comps {
-		keycloakClient.DeleteComponent(realm.Realm, comp.Id)
+		keycloakClient.DeleteComponent(ctx, realm.Realm, comp.Id)
 	}
 
 	setRealmData(data, realm)
 
-	return resourceKeycloakRealmRead(data, meta)
+	return resourceKeycloakRealmRead(ctx, data, meta)
 }
 
-func resourceKeycloakRealmRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakRealmRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	realm, err := keycloakClient.GetRealm(data.Id())
+	realm, err := keycloakClient.GetRealm(ctx, data.Id())
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	// // we can't trust the API to set this field correctly since it just responds with "**********" this implies a 'password only' change will not detected
@@ -1163,22 +1643,22 @@ func resourceKeycloakRealmRead(data *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceKeycloakRealmUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakRealmUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realm, err := getRealmFromData(data)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.ValidateRealm(realm)
+	err = keycloakClient.ValidateRealm(ctx, realm)
 	if err != nil {
-		return err
+		return handleValidationError(err, realmValidationAttributePaths)
 	}
 
-	err = keycloakClient.UpdateRealm(realm)
+	err = keycloakClient.UpdateRealm(ctx, realm)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	setRealmData(data, realm)
@@ -1186,8 +1666,12 @@ func resourceKeycloakRealmUpdate(data *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceKeycloakRealmDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakRealmDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	return keycloakClient.DeleteRealm(data.Id())
+	if err := keycloakClient.DeleteRealm(ctx, data.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
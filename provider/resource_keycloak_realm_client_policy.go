@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmClientPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmClientPolicyCreate,
+		ReadContext:   resourceKeycloakRealmClientPolicyRead,
+		UpdateContext: resourceKeycloakRealmClientPolicyUpdate,
+		DeleteContext: resourceKeycloakRealmClientPolicyDelete,
+		// This resource can be imported using {{realm}}/{{policy_name}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmClientPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"condition": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The conditions a client has to match for the policy to apply to it.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The provider id of the condition, such as client-roles.",
+						},
+						"configuration": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressJsonDiff,
+							Description:      "The JSON encoded configuration of the condition.",
+						},
+					},
+				},
+			},
+			"profiles": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The names of the client policy profiles applied to matching clients, in order.",
+			},
+		},
+	}
+}
+
+func getRealmClientPolicyFromData(data *schema.ResourceData) (*keycloak.RealmClientPolicy, error) {
+	policy := &keycloak.RealmClientPolicy{
+		RealmId:     data.Get("realm_id").(string),
+		Name:        data.Get("name").(string),
+		Description: data.Get("description").(string),
+		Enabled:     data.Get("enabled").(bool),
+		Conditions:  []*keycloak.RealmClientPolicyCondition{},
+		Profiles:    []string{},
+	}
+
+	// Keycloak rejects policies whose profiles are null, so no profiles have to be sent as an empty list
+	for _, profile := range data.Get("profiles").([]interface{}) {
+		policy.Profiles = append(policy.Profiles, profile.(string))
+	}
+
+	for _, c := range data.Get("condition").([]interface{}) {
+		condition := c.(map[string]interface{})
+
+		configuration, err := getClientPolicyConfigurationFromData(condition["configuration"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		policy.Conditions = append(policy.Conditions, &keycloak.RealmClientPolicyCondition{
+			Condition:     condition["name"].(string),
+			Configuration: configuration,
+		})
+	}
+
+	return policy, nil
+}
+
+func setRealmClientPolicyData(data *schema.ResourceData, policy *keycloak.RealmClientPolicy) error {
+	var conditions []interface{}
+	for _, condition := range policy.Conditions {
+		configuration, err := getClientPolicyConfigurationData(condition.Configuration)
+		if err != nil {
+			return err
+		}
+
+		conditions = append(conditions, map[string]interface{}{
+			"name":          condition.Condition,
+			"configuration": configuration,
+		})
+	}
+
+	data.SetId(realmClientPolicyId(policy.RealmId, policy.Name))
+	data.Set("realm_id", policy.RealmId)
+	data.Set("name", policy.Name)
+	data.Set("description", policy.Description)
+	data.Set("enabled", policy.Enabled)
+	data.Set("condition", conditions)
+	data.Set("profiles", policy.Profiles)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy, err := getRealmClientPolicyFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewRealmClientPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmClientPolicyId(policy.RealmId, policy.Name))
+
+	return resourceKeycloakRealmClientPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	policy, err := keycloakClient.GetRealmClientPolicy(ctx, realmId, name)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if err := setRealmClientPolicyData(data, policy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmClientPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy, err := getRealmClientPolicyFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateRealmClientPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmClientPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	if err := keycloakClient.DeleteRealmClientPolicy(ctx, realmId, name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmClientPolicyProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmClientPolicyProfileCreate,
+		ReadContext:   resourceKeycloakRealmClientPolicyProfileRead,
+		UpdateContext: resourceKeycloakRealmClientPolicyProfileUpdate,
+		DeleteContext: resourceKeycloakRealmClientPolicyProfileDelete,
+		// This resource can be imported using {{realm}}/{{profile_name}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmClientPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"executor": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The executors of the profile, in the order they are run.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The provider id of the executor, such as pkce-enforcer.",
+						},
+						"configuration": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressJsonDiff,
+							Description:      "The JSON encoded configuration of the executor.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func realmClientPolicyId(realmId, name string) string {
+	return realmId + "/" + name
+}
+
+// The configurations of executors and conditions are JSON objects, which are managed as their JSON encoding
+func getClientPolicyConfigurationFromData(configuration string) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	if configuration == "" {
+		return result, nil
+	}
+
+	if err := json.Unmarshal([]byte(configuration), &result); err != nil {
+		return nil, fmt.Errorf("configuration must be a JSON object: %w", err)
+	}
+
+	return result, nil
+}
+
+func getClientPolicyConfigurationData(configuration map[string]interface{}) (string, error) {
+	if len(configuration) == 0 {
+		return "", nil
+	}
+
+	encoded, err := json.Marshal(configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func getRealmClientPolicyProfileFromData(data *schema.ResourceData) (*keycloak.RealmClientPolicyProfile, error) {
+	profile := &keycloak.RealmClientPolicyProfile{
+		RealmId:     data.Get("realm_id").(string),
+		Name:        data.Get("name").(string),
+		Description: data.Get("description").(string),
+		Executors:   []*keycloak.RealmClientPolicyProfileExecutor{},
+	}
+
+	for _, e := range data.Get("executor").([]interface{}) {
+		executor := e.(map[string]interface{})
+
+		configuration, err := getClientPolicyConfigurationFromData(executor["configuration"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		profile.Executors = append(profile.Executors, &keycloak.RealmClientPolicyProfileExecutor{
+			Executor:      executor["name"].(string),
+			Configuration: configuration,
+		})
+	}
+
+	return profile, nil
+}
+
+func setRealmClientPolicyProfileData(data *schema.ResourceData, profile *keycloak.RealmClientPolicyProfile) error {
+	var executors []interface{}
+	for _, executor := range profile.Executors {
+		configuration, err := getClientPolicyConfigurationData(executor.Configuration)
+		if err != nil {
+			return err
+		}
+
+		executors = append(executors, map[string]interface{}{
+			"name":          executor.Executor,
+			"configuration": configuration,
+		})
+	}
+
+	data.SetId(realmClientPolicyId(profile.RealmId, profile.Name))
+	data.Set("realm_id", profile.RealmId)
+	data.Set("name", profile.Name)
+	data.Set("description", profile.Description)
+	data.Set("executor", executors)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientPolicyProfileCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	profile, err := getRealmClientPolicyProfileFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewRealmClientPolicyProfile(ctx, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmClientPolicyId(profile.RealmId, profile.Name))
+
+	return resourceKeycloakRealmClientPolicyProfileRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyProfileRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	profile, err := keycloakClient.GetRealmClientPolicyProfile(ctx, realmId, name)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if err := setRealmClientPolicyProfileData(data, profile); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmClientPolicyProfileUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	profile, err := getRealmClientPolicyProfileFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateRealmClientPolicyProfile(ctx, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmClientPolicyProfileRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyProfileDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	name := data.Get("name").(string)
+
+	if err := keycloakClient.DeleteRealmClientPolicyProfile(ctx, realmId, name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Client policies and profiles are both imported using {{realm}}/{{name}}
+func resourceKeycloakRealmClientPolicyImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{name}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("name", parts[1])
+	d.SetId(realmClientPolicyId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
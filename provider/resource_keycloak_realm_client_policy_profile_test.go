@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmClientPolicyProfile_basic(t *testing.T) {
+	t.Parallel()
+	profileName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientPolicyProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicyProfile_basic(profileName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyProfileExecutors("keycloak_realm_client_policy_profile.profile", "pkce-enforcer", "full-scope-disabled"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.0.name", "pkce-enforcer"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.0.configuration", `{"auto-configure":"true"}`),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.1.configuration", ""),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_client_policy_profile.profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccRealm.Realm + "/" + profileName,
+			},
+			{
+				Config: testKeycloakRealmClientPolicyProfile_singleExecutor(profileName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyProfileExecutors("keycloak_realm_client_policy_profile.profile", "full-scope-disabled"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile.profile", "executor.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// Profiles are written as a whole, so profiles created at the same time mustn't overwrite each other
+func TestAccKeycloakRealmClientPolicyProfile_multiple(t *testing.T) {
+	t.Parallel()
+	profileName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientPolicyProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicyProfile_multiple(profileName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyProfileExists("keycloak_realm_client_policy_profile.profile.0"),
+					testAccCheckKeycloakRealmClientPolicyProfileExists("keycloak_realm_client_policy_profile.profile.4"),
+				),
+			},
+			{
+				Config: testKeycloakRealmClientPolicyProfile_multiple(profileName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyProfileExists("keycloak_realm_client_policy_profile.profile.0"),
+					testAccCheckKeycloakRealmClientPolicyProfileExists("keycloak_realm_client_policy_profile.profile.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientPolicyProfile_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	profileName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientPolicyProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicyProfile_basic(profileName),
+				Check:  testAccCheckKeycloakRealmClientPolicyProfileExists("keycloak_realm_client_policy_profile.profile"),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteRealmClientPolicyProfile(testCtx, testAccRealm.Realm, profileName)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmClientPolicyProfile_basic(profileName),
+				Check:  testAccCheckKeycloakRealmClientPolicyProfileExists("keycloak_realm_client_policy_profile.profile"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientPolicyProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getRealmClientPolicyProfileFromState(s, resourceName)
+
+		return err
+	}
+}
+
+// Checks the executors are sent in order, and that their configuration is sent as a JSON object rather than the string
+// it's configured as
+func testAccCheckKeycloakRealmClientPolicyProfileExecutors(resourceName string, executors ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		profile, err := getRealmClientPolicyProfileFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if len(profile.Executors) != len(executors) {
+			return fmt.Errorf("expected client policy profile %s to have %d executors, got %d", profile.Name, len(executors), len(profile.Executors))
+		}
+
+		for i, executor := range executors {
+			if profile.Executors[i].Executor != executor {
+				return fmt.Errorf("expected executor %d to be %s, got %s", i, executor, profile.Executors[i].Executor)
+			}
+			if executor == "pkce-enforcer" && profile.Executors[i].Configuration["auto-configure"] != "true" {
+				return fmt.Errorf("expected the configuration to be sent as a JSON object, got %#v", profile.Executors[i].Configuration)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmClientPolicyProfileDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_client_policy_profile" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			name := rs.Primary.Attributes["name"]
+
+			profile, _ := keycloakClient.GetRealmClientPolicyProfile(testCtx, realmId, name)
+			if profile != nil {
+				return fmt.Errorf("client policy profile %s still exists", name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRealmClientPolicyProfileFromState(s *terraform.State, resourceName string) (*keycloak.RealmClientPolicyProfile, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realmId := rs.Primary.Attributes["realm_id"]
+	name := rs.Primary.Attributes["name"]
+
+	profile, err := keycloakClient.GetRealmClientPolicyProfile(testCtx, realmId, name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client policy profile %s: %s", name, err)
+	}
+
+	return profile, nil
+}
+
+func testKeycloakRealmClientPolicyProfile_basic(profileName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s"
+	description = "Enforces PKCE"
+
+	executor {
+		name          = "pkce-enforcer"
+		configuration = jsonencode({
+			auto-configure = "true"
+		})
+	}
+
+	executor {
+		name = "full-scope-disabled"
+	}
+}
+	`, testAccRealm.Realm, profileName)
+}
+
+func testKeycloakRealmClientPolicyProfile_singleExecutor(profileName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s"
+	description = "Enforces PKCE"
+
+	executor {
+		name = "full-scope-disabled"
+	}
+}
+	`, testAccRealm.Realm, profileName)
+}
+
+func testKeycloakRealmClientPolicyProfile_multiple(profileName string, count int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	count    = %d
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-${count.index}"
+
+	executor {
+		name = "full-scope-disabled"
+	}
+}
+	`, testAccRealm.Realm, count, profileName)
+}
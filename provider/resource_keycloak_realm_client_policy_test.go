@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmClientPolicy_basic(t *testing.T) {
+	t.Parallel()
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicy_basic(policyName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicySettings("keycloak_realm_client_policy.policy", true, 1),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy.policy", "condition.0.name", "client-roles"),
+					resource.TestCheckResourceAttrPair("keycloak_realm_client_policy.policy", "profiles.0", "keycloak_realm_client_policy_profile.profile", "name"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_client_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccRealm.Realm + "/" + policyName,
+			},
+			{
+				Config: testKeycloakRealmClientPolicy_basic(policyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicySettings("keycloak_realm_client_policy.policy", false, 1),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy.policy", "enabled", "false"),
+				),
+			},
+			{
+				Config: testKeycloakRealmClientPolicy_withoutProfiles(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicySettings("keycloak_realm_client_policy.policy", true, 0),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy.policy", "profiles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientPolicy_invalidConfiguration(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientPolicy_invalidConfiguration(acctest.RandomWithPrefix("tf-acc")),
+				ExpectError: regexp.MustCompile("contains an invalid JSON"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientPolicy_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicy_withoutProfiles(policyName),
+				Check:  testAccCheckKeycloakRealmClientPolicyExists("keycloak_realm_client_policy.policy"),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteRealmClientPolicy(testCtx, testAccRealm.Realm, policyName)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmClientPolicy_withoutProfiles(policyName),
+				Check:  testAccCheckKeycloakRealmClientPolicyExists("keycloak_realm_client_policy.policy"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		name := rs.Primary.Attributes["name"]
+
+		_, err := keycloakClient.GetRealmClientPolicy(testCtx, realmId, name)
+		if err != nil {
+			return fmt.Errorf("error getting client policy %s: %s", name, err)
+		}
+
+		return nil
+	}
+}
+
+// Checks the condition configuration is sent as a JSON object rather than the string it's configured as
+func testAccCheckKeycloakRealmClientPolicySettings(resourceName string, enabled bool, profiles int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		name := rs.Primary.Attributes["name"]
+
+		policy, err := keycloakClient.GetRealmClientPolicy(testCtx, realmId, name)
+		if err != nil {
+			return fmt.Errorf("error getting client policy %s: %s", name, err)
+		}
+
+		if policy.Enabled != enabled || len(policy.Profiles) != profiles {
+			return fmt.Errorf("expected client policy %s to be enabled %t with %d profiles, got %+v", name, enabled, profiles, policy)
+		}
+		if len(policy.Conditions) != 1 {
+			return fmt.Errorf("expected client policy %s to have 1 condition, got %d", name, len(policy.Conditions))
+		}
+		if roles, ok := policy.Conditions[0].Configuration["roles"].([]interface{}); !ok || len(roles) != 1 {
+			return fmt.Errorf("expected the configuration to be sent as a JSON object, got %#v", policy.Conditions[0].Configuration)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmClientPolicyDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_client_policy" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			name := rs.Primary.Attributes["name"]
+
+			policy, _ := keycloakClient.GetRealmClientPolicy(testCtx, realmId, name)
+			if policy != nil {
+				return fmt.Errorf("client policy %s still exists", name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmClientPolicy_basic(policyName string, enabled bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-profile"
+
+	executor {
+		name = "pkce-enforcer"
+	}
+}
+
+resource "keycloak_realm_client_policy" "policy" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+	enabled  = %t
+
+	condition {
+		name          = "client-roles"
+		configuration = jsonencode({
+			roles = ["confidential"]
+		})
+	}
+
+	profiles = [
+		keycloak_realm_client_policy_profile.profile.name,
+	]
+}
+	`, testAccRealm.Realm, policyName, policyName, enabled)
+}
+
+func testKeycloakRealmClientPolicy_withoutProfiles(policyName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy" "policy" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+
+	condition {
+		name          = "client-roles"
+		configuration = jsonencode({
+			roles = ["confidential"]
+		})
+	}
+}
+	`, testAccRealm.Realm, policyName)
+}
+
+func testKeycloakRealmClientPolicy_invalidConfiguration(policyName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy" "policy" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+
+	condition {
+		name          = "any-client"
+		configuration = "{not json"
+	}
+}
+	`, testAccRealm.Realm, policyName)
+}
@@ -39,7 +39,7 @@ func TestAccKeycloakRealmEvents_destroy(t *testing.T) {
 			{
 				Config: testKeycloakRealmEvents_realmOnly(realmName),
 				Check: func(state *terraform.State) error {
-					realmEventsConfig, err := keycloakClient.GetRealmEventsConfig(realmName)
+					realmEventsConfig, err := keycloakClient.GetRealmEventsConfig(testCtx, realmName)
 					if err != nil {
 						return err
 					}
@@ -185,7 +185,7 @@ func getRealmEventsFromState(s *terraform.State, resourceName string) (*keycloak
 
 	realm := rs.Primary.Attributes["realm_id"]
 
-	realmEventsConfig, err := keycloakClient.GetRealmEventsConfig(realm)
+	realmEventsConfig, err := keycloakClient.GetRealmEventsConfig(testCtx, realm)
 	if err != nil {
 		return nil, fmt.Errorf("error getting realm events config: %s", err)
 	}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKeycloakRealmKeystoreAesGenerated() *schema.Resource {
+	aesSchema := map[string]*schema.Schema{
+		"secret_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      16,
+			ValidateFunc: validation.IntInSlice([]int{16, 24, 32}),
+			Description:  "Size in bytes of the generated secret.",
+		},
+	}
+
+	return resourceKeycloakRealmKeystoreGenerated("aes-generated", aesSchema, map[string]string{
+		"secret_size": "secretSize",
+	})
+}
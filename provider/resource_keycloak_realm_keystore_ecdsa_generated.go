@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKeycloakRealmKeystoreEcdsaGenerated() *schema.Resource {
+	ecdsaSchema := map[string]*schema.Schema{
+		"elliptic_curve_key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "P-256",
+			ValidateFunc: validation.StringInSlice([]string{"P-256", "P-384", "P-521"}, false),
+			Description:  "Elliptic curve used to generate the keys.",
+		},
+	}
+
+	return resourceKeycloakRealmKeystoreGenerated("ecdsa-generated", ecdsaSchema, map[string]string{
+		"elliptic_curve_key": "ecdsaEllipticCurveKey",
+	})
+}
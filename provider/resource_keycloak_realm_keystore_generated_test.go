@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+// Each generated keystore is tested by setting one of its attributes, and changing it afterwards
+var realmKeystoreGeneratedTests = []struct {
+	resourceType string
+	providerId   string
+
+	attribute    string
+	configKey    string
+	value        interface{}
+	updatedValue interface{}
+	defaults     map[string]string
+}{
+	{
+		resourceType: "keycloak_realm_keystore_rsa_generated",
+		providerId:   "rsa-generated",
+		attribute:    "key_size",
+		configKey:    "keySize",
+		value:        2048,
+		updatedValue: 4096,
+		defaults:     map[string]string{"algorithm": "RS256", "key_size": "2048"},
+	},
+	{
+		resourceType: "keycloak_realm_keystore_hmac_generated",
+		providerId:   "hmac-generated",
+		attribute:    "secret_size",
+		configKey:    "secretSize",
+		value:        64,
+		updatedValue: 128,
+		defaults:     map[string]string{"algorithm": "HS256", "secret_size": "64"},
+	},
+	{
+		resourceType: "keycloak_realm_keystore_aes_generated",
+		providerId:   "aes-generated",
+		attribute:    "secret_size",
+		configKey:    "secretSize",
+		value:        16,
+		updatedValue: 32,
+		defaults:     map[string]string{"secret_size": "16"},
+	},
+	{
+		resourceType: "keycloak_realm_keystore_ecdsa_generated",
+		providerId:   "ecdsa-generated",
+		attribute:    "elliptic_curve_key",
+		configKey:    "ecdsaEllipticCurveKey",
+		value:        "P-256",
+		updatedValue: "P-384",
+		defaults:     map[string]string{"elliptic_curve_key": "P-256"},
+	},
+}
+
+func TestAccKeycloakRealmKeystoreGenerated_basic(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range realmKeystoreGeneratedTests {
+		test := test
+		t.Run(test.resourceType, func(t *testing.T) {
+			t.Parallel()
+			keystoreName := acctest.RandomWithPrefix("tf-acc")
+			resourceName := test.resourceType + ".keystore"
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakRealmKeystoreGeneratedDestroy(test.resourceType, test.providerId),
+				Steps: []resource.TestStep{
+					{
+						Config: testKeycloakRealmKeystoreGenerated_basic(test.resourceType, keystoreName, test.attribute, test.value),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckKeycloakRealmKeystoreGeneratedExists(resourceName, test.providerId),
+							testAccCheckKeycloakRealmKeystoreGeneratedDefaults(resourceName, test.defaults),
+							testAccCheckKeycloakRealmKeystoreGeneratedConfig(resourceName, test.providerId, test.configKey, fmt.Sprint(test.value)),
+						),
+					},
+					{
+						ResourceName:      resourceName,
+						ImportState:       true,
+						ImportStateVerify: true,
+						ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+					},
+					{
+						Config: testKeycloakRealmKeystoreGenerated_basic(test.resourceType, keystoreName, test.attribute, test.updatedValue),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, test.attribute, fmt.Sprint(test.updatedValue)),
+							testAccCheckKeycloakRealmKeystoreGeneratedConfig(resourceName, test.providerId, test.configKey, fmt.Sprint(test.updatedValue)),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccKeycloakRealmKeystoreGenerated_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range realmKeystoreGeneratedTests {
+		test := test
+		t.Run(test.resourceType, func(t *testing.T) {
+			t.Parallel()
+			var keystore = &keycloak.RealmKeystoreGenerated{}
+
+			keystoreName := acctest.RandomWithPrefix("tf-acc")
+			resourceName := test.resourceType + ".keystore"
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakRealmKeystoreGeneratedDestroy(test.resourceType, test.providerId),
+				Steps: []resource.TestStep{
+					{
+						Config: testKeycloakRealmKeystoreGenerated_basic(test.resourceType, keystoreName, test.attribute, test.value),
+						Check:  testAccCheckKeycloakRealmKeystoreGeneratedFetch(resourceName, test.providerId, keystore),
+					},
+					{
+						PreConfig: func() {
+							err := keycloakClient.DeleteRealmKeystoreGenerated(testCtx, keystore.RealmId, keystore.Id)
+							if err != nil {
+								t.Fatal(err)
+							}
+						},
+						Config: testKeycloakRealmKeystoreGenerated_basic(test.resourceType, keystoreName, test.attribute, test.value),
+						Check:  testAccCheckKeycloakRealmKeystoreGeneratedExists(resourceName, test.providerId),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreGeneratedExists(resourceName, providerId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakRealmKeystoreGeneratedFromState(s, resourceName, providerId)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// Checks the settings that aren't configured, as the generated keystores fall back to the same defaults as Keycloak
+func testAccCheckKeycloakRealmKeystoreGeneratedDefaults(resourceName string, defaults map[string]string) resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	for attribute, value := range defaults {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, attribute, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+// Checks the setting is stored under the given config key, and that the keystore can't be read as one of a different
+// provider
+func testAccCheckKeycloakRealmKeystoreGeneratedConfig(resourceName, providerId, configKey, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keystore, err := getKeycloakRealmKeystoreGeneratedFromState(s, resourceName, providerId)
+		if err != nil {
+			return err
+		}
+
+		if keystore.Config[configKey] != value {
+			return fmt.Errorf("expected %s keystore %s to have config %s with value %s, got %v", providerId, keystore.Id, configKey, value, keystore.Config)
+		}
+
+		if _, err := keycloakClient.GetRealmKeystoreGenerated(testCtx, keystore.RealmId, keystore.Id, "rsa"); err == nil {
+			return fmt.Errorf("expected reading the %s keystore %s as an rsa keystore to fail", providerId, keystore.Id)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreGeneratedFetch(resourceName, providerId string, keystore *keycloak.RealmKeystoreGenerated) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedKeystore, err := getKeycloakRealmKeystoreGeneratedFromState(s, resourceName, providerId)
+		if err != nil {
+			return err
+		}
+
+		keystore.Id = fetchedKeystore.Id
+		keystore.RealmId = fetchedKeystore.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreGeneratedDestroy(resourceType, providerId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keystore, _ := keycloakClient.GetRealmKeystoreGenerated(testCtx, realm, id, providerId)
+			if keystore != nil {
+				return fmt.Errorf("%s keystore with id %s still exists", providerId, id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakRealmKeystoreGeneratedFromState(s *terraform.State, resourceName, providerId string) (*keycloak.RealmKeystoreGenerated, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	keystore, err := keycloakClient.GetRealmKeystoreGenerated(testCtx, realm, id, providerId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s keystore with id %s: %s", providerId, id, err)
+	}
+
+	return keystore, nil
+}
+
+func testKeycloakRealmKeystoreGenerated_basic(resourceType, keystoreName, attribute string, value interface{}) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "%s" "keystore" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	priority = 100
+	%s = %q
+}
+	`, testAccRealm.Realm, resourceType, keystoreName, attribute, fmt.Sprint(value))
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKeycloakRealmKeystoreHmacGenerated() *schema.Resource {
+	hmacSchema := map[string]*schema.Schema{
+		"algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "HS256",
+			ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreHmacAlgorithms, false),
+			Description:  "Intended algorithm for the key.",
+		},
+		"secret_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      64,
+			ValidateFunc: validation.IntInSlice([]int{16, 24, 32, 64, 128, 256, 512}),
+			Description:  "Size in bytes of the generated secret.",
+		},
+	}
+
+	return resourceKeycloakRealmKeystoreGenerated("hmac-generated", hmacSchema, map[string]string{
+		"algorithm":   "algorithm",
+		"secret_size": "secretSize",
+	})
+}
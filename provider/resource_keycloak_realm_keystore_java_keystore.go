@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmKeystoreJavaKeystore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmKeystoreJavaKeystoreCreate,
+		ReadContext:   resourceKeycloakRealmKeystoreJavaKeystoreRead,
+		UpdateContext: resourceKeycloakRealmKeystoreJavaKeystoreUpdate,
+		DeleteContext: resourceKeycloakRealmKeystoreJavaKeystoreDelete,
+		// This resource can be imported using {{realm}}/{{keystore_id}}. The keystore ID is displayed in the URL when editing it from the GUI
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmKeystoreImport,
+		},
+		Schema: realmKeystoreSchema(map[string]*schema.Schema{
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RS256",
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreRsaAlgorithms, false),
+				Description:  "Intended algorithm for the key.",
+			},
+			"keystore": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to the keystore file on the Keycloak server.",
+			},
+			"keystore_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password for the keystore.",
+			},
+			"key_alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Alias of the private key in the keystore.",
+			},
+			"key_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password for the private key.",
+			},
+		}),
+	}
+}
+
+func getRealmKeystoreJavaKeystoreFromData(data *schema.ResourceData) *keycloak.RealmKeystoreJavaKeystore {
+	return &keycloak.RealmKeystoreJavaKeystore{
+		RealmKeystore:    getRealmKeystoreFromData(data),
+		Algorithm:        data.Get("algorithm").(string),
+		Keystore:         data.Get("keystore").(string),
+		KeystorePassword: data.Get("keystore_password").(string),
+		KeyAlias:         data.Get("key_alias").(string),
+		KeyPassword:      data.Get("key_password").(string),
+	}
+}
+
+func setRealmKeystoreJavaKeystoreData(data *schema.ResourceData, keystore *keycloak.RealmKeystoreJavaKeystore) {
+	setRealmKeystoreData(data, keystore.RealmKeystore)
+	data.Set("algorithm", keystore.Algorithm)
+	data.Set("keystore", keystore.Keystore)
+	data.Set("key_alias", keystore.KeyAlias)
+}
+
+func resourceKeycloakRealmKeystoreJavaKeystoreCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreJavaKeystoreFromData(data)
+
+	err := keycloakClient.NewRealmKeystoreJavaKeystore(ctx, keystore)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(keystore.Id)
+
+	return resourceKeycloakRealmKeystoreJavaKeystoreRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeystoreJavaKeystoreRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	keystore, err := keycloakClient.GetRealmKeystoreJavaKeystore(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmKeystoreJavaKeystoreData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreJavaKeystoreUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreJavaKeystoreFromData(data)
+
+	err := keycloakClient.UpdateRealmKeystoreJavaKeystore(ctx, keystore)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmKeystoreJavaKeystoreRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeystoreJavaKeystoreDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	if err := keycloakClient.DeleteRealmKeystoreJavaKeystore(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The Keycloak server used for the tests doesn't have a keystore, so only the rejection of an invalid one is tested
+func TestAccKeycloakRealmKeystoreJavaKeystore_invalidKeystore(t *testing.T) {
+	if testServer != nil {
+		t.Skip("the in-memory Keycloak server doesn't validate keystores")
+	}
+
+	t.Parallel()
+	keystoreName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmKeystoreJavaKeystoreDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmKeystoreJavaKeystore_basic(keystoreName, "/tmp/"+keystoreName+".jks"),
+				ExpectError: regexp.MustCompile("Failed to load keys"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmKeystoreJavaKeystoreDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_java_keystore" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keystore, _ := keycloakClient.GetRealmKeystoreJavaKeystore(testCtx, realm, id)
+			if keystore != nil {
+				return fmt.Errorf("java keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmKeystoreJavaKeystore_basic(keystoreName, keystore string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_java_keystore" "realm_java_keystore" {
+	name              = "%s"
+	realm_id          = data.keycloak_realm.realm.id
+
+	priority          = 100
+	algorithm         = "RS256"
+
+	keystore          = "%s"
+	keystore_password = "password"
+	key_alias         = "server"
+	key_password      = "password"
+}
+	`, testAccRealm.Realm, keystoreName, keystore)
+}
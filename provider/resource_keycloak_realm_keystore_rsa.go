@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmKeystoreRsa() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmKeystoreRsaCreate,
+		ReadContext:   resourceKeycloakRealmKeystoreRsaRead,
+		UpdateContext: resourceKeycloakRealmKeystoreRsaUpdate,
+		DeleteContext: resourceKeycloakRealmKeystoreRsaDelete,
+		// This resource can be imported using {{realm}}/{{keystore_id}}. The keystore ID is displayed in the URL when editing it from the GUI
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmKeystoreImport,
+		},
+		Schema: realmKeystoreSchema(map[string]*schema.Schema{
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RS256",
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreRsaAlgorithms, false),
+				Description:  "Intended algorithm for the key.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "PEM encoded RSA private key.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "PEM encoded X509 certificate for the private key. Keycloak generates a self signed certificate if none is given.",
+			},
+		}),
+	}
+}
+
+func getRealmKeystoreRsaFromData(data *schema.ResourceData) *keycloak.RealmKeystoreRsa {
+	return &keycloak.RealmKeystoreRsa{
+		RealmKeystore: getRealmKeystoreFromData(data),
+		Algorithm:     data.Get("algorithm").(string),
+		PrivateKey:    data.Get("private_key").(string),
+		Certificate:   data.Get("certificate").(string),
+	}
+}
+
+func setRealmKeystoreRsaData(data *schema.ResourceData, keystore *keycloak.RealmKeystoreRsa) {
+	setRealmKeystoreData(data, keystore.RealmKeystore)
+	data.Set("algorithm", keystore.Algorithm)
+	data.Set("certificate", keystore.Certificate)
+}
+
+func resourceKeycloakRealmKeystoreRsaCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreRsaFromData(data)
+
+	err := keycloakClient.ValidateRealmKeystoreRsa(ctx, keystore)
+	if err != nil {
+		return handleValidationError(err, nil)
+	}
+
+	err = keycloakClient.NewRealmKeystoreRsa(ctx, keystore)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(keystore.Id)
+
+	return resourceKeycloakRealmKeystoreRsaRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeystoreRsaRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	keystore, err := keycloakClient.GetRealmKeystoreRsa(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmKeystoreRsaData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreRsaUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreRsaFromData(data)
+
+	// the certificate belongs to the old key, so Keycloak has to generate a new one
+	if data.HasChange("private_key") && !data.HasChange("certificate") {
+		keystore.Certificate = ""
+	}
+
+	err := keycloakClient.ValidateRealmKeystoreRsa(ctx, keystore)
+	if err != nil {
+		return handleValidationError(err, nil)
+	}
+
+	err = keycloakClient.UpdateRealmKeystoreRsa(ctx, keystore)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmKeystoreRsaRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeystoreRsaDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	if err := keycloakClient.DeleteRealmKeystoreRsa(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKeycloakRealmKeystoreRsaGenerated() *schema.Resource {
+	rsaSchema := map[string]*schema.Schema{
+		"algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "RS256",
+			ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreRsaAlgorithms, false),
+			Description:  "Intended algorithm for the key.",
+		},
+		"key_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      2048,
+			ValidateFunc: validation.IntInSlice([]int{1024, 2048, 4096}),
+			Description:  "Size in bits of the generated keys.",
+		},
+	}
+
+	return resourceKeycloakRealmKeystoreGenerated("rsa-generated", rsaSchema, map[string]string{
+		"algorithm": "algorithm",
+		"key_size":  "keySize",
+	})
+}
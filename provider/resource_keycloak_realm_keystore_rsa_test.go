@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmKeystoreRsa_basic(t *testing.T) {
+	t.Parallel()
+	keystoreName := acctest.RandomWithPrefix("tf-acc")
+	certificate, privateKey := randTLSCert(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsa_basic(keystoreName, privateKey, certificate),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaExists("keycloak_realm_keystore_rsa.realm_rsa"),
+			},
+			{
+				ResourceName:            "keycloak_realm_keystore_rsa.realm_rsa",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       getRealmKeystoreGenericImportId("keycloak_realm_keystore_rsa.realm_rsa"),
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsa_generatedCertificate(t *testing.T) {
+	t.Parallel()
+	keystoreName := acctest.RandomWithPrefix("tf-acc")
+	_, privateKey := randTLSCert(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsa_withoutCertificate(keystoreName, privateKey),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaExists("keycloak_realm_keystore_rsa.realm_rsa"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsa_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var keystore = &keycloak.RealmKeystoreRsa{}
+
+	keystoreName := acctest.RandomWithPrefix("tf-acc")
+	certificate, privateKey := randTLSCert(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsa_basic(keystoreName, privateKey, certificate),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaFetch("keycloak_realm_keystore_rsa.realm_rsa", keystore),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteRealmKeystoreRsa(testCtx, keystore.RealmId, keystore.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmKeystoreRsa_basic(keystoreName, privateKey, certificate),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaExists("keycloak_realm_keystore_rsa.realm_rsa"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsa_updateKey(t *testing.T) {
+	t.Parallel()
+	keystoreName := acctest.RandomWithPrefix("tf-acc")
+	certificate, privateKey := randTLSCert(t)
+	_, newPrivateKey := randTLSCert(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsa_basic(keystoreName, privateKey, certificate),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaExists("keycloak_realm_keystore_rsa.realm_rsa"),
+			},
+			{
+				Config: testKeycloakRealmKeystoreRsa_withoutCertificate(keystoreName, newPrivateKey),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaExists("keycloak_realm_keystore_rsa.realm_rsa"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsa_invalidPem(t *testing.T) {
+	t.Parallel()
+	keystoreName := acctest.RandomWithPrefix("tf-acc")
+	certificate, privateKey := randTLSCert(t)
+	otherCertificate, _ := randTLSCert(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmKeystoreRsa_basic(keystoreName, "not a key", certificate),
+				ExpectError: regexp.MustCompile("private key must be PEM encoded"),
+			},
+			{
+				Config:      testKeycloakRealmKeystoreRsa_basic(keystoreName, privateKey, "not a certificate"),
+				ExpectError: regexp.MustCompile("certificate must be PEM encoded"),
+			},
+			{
+				Config:      testKeycloakRealmKeystoreRsa_basic(keystoreName, privateKey, otherCertificate),
+				ExpectError: regexp.MustCompile("certificate does not match the private key"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsa_updateSettings(t *testing.T) {
+	t.Parallel()
+	keystoreName := acctest.RandomWithPrefix("tf-acc")
+	certificate, privateKey := randTLSCert(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsa_basic(keystoreName, privateKey, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeystoreRsaSettings("keycloak_realm_keystore_rsa.realm_rsa", 100, true),
+					resource.TestCheckResourceAttr("keycloak_realm_keystore_rsa.realm_rsa", "certificate", certificate),
+				),
+			},
+			{
+				Config: testKeycloakRealmKeystoreRsa_settings(keystoreName, privateKey, certificate, 200, false),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaSettings("keycloak_realm_keystore_rsa.realm_rsa", 200, false),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakRealmKeystoreRsaFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaSettings(resourceName string, priority int, active bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keystore, err := getKeycloakRealmKeystoreRsaFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if keystore.Priority != priority || keystore.Active != active || !keystore.Enabled {
+			return fmt.Errorf("expected rsa keystore %s to have priority %d and active %t, got %+v", keystore.Id, priority, active, keystore)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaFetch(resourceName string, keystore *keycloak.RealmKeystoreRsa) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedKeystore, err := getKeycloakRealmKeystoreRsaFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		keystore.Id = fetchedKeystore.Id
+		keystore.RealmId = fetchedKeystore.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_rsa" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keystore, _ := keycloakClient.GetRealmKeystoreRsa(testCtx, realm, id)
+			if keystore != nil {
+				return fmt.Errorf("rsa keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakRealmKeystoreRsaFromState(s *terraform.State, resourceName string) (*keycloak.RealmKeystoreRsa, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	keystore, err := keycloakClient.GetRealmKeystoreRsa(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rsa keystore with id %s: %s", id, err)
+	}
+
+	return keystore, nil
+}
+
+// Returns a PEM encoded self signed certificate and its PKCS#1 encoded RSA private key
+func randTLSCert(t *testing.T) (string, string) {
+	certificate, privateKey, err := acctest.RandTLSCert("tf-acc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return certificate, privateKey
+}
+
+func getRealmKeystoreGenericImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		id := rs.Primary.ID
+		realmId := rs.Primary.Attributes["realm_id"]
+
+		return fmt.Sprintf("%s/%s", realmId, id), nil
+	}
+}
+
+func testKeycloakRealmKeystoreRsa_basic(keystoreName, privateKey, certificate string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa" "realm_rsa" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	priority  = 100
+	algorithm = "RS256"
+
+	private_key = <<EOT
+%sEOT
+	certificate = <<EOT
+%sEOT
+}
+	`, testAccRealm.Realm, keystoreName, privateKey, certificate)
+}
+
+func testKeycloakRealmKeystoreRsa_withoutCertificate(keystoreName, privateKey string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa" "realm_rsa" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	priority  = 100
+	algorithm = "RS256"
+
+	private_key = <<EOT
+%sEOT
+}
+	`, testAccRealm.Realm, keystoreName, privateKey)
+}
+
+func testKeycloakRealmKeystoreRsa_settings(keystoreName, privateKey, certificate string, priority int, active bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa" "realm_rsa" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	priority  = %d
+	active    = %t
+	algorithm = "RS256"
+
+	private_key = <<EOT
+%sEOT
+	certificate = <<EOT
+%sEOT
+}
+	`, testAccRealm.Realm, keystoreName, priority, active, privateKey, certificate)
+}
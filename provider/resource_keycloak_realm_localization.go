@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmLocalization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmLocalizationCreate,
+		ReadContext:   resourceKeycloakRealmLocalizationRead,
+		UpdateContext: resourceKeycloakRealmLocalizationUpdate,
+		DeleteContext: resourceKeycloakRealmLocalizationDelete,
+		// This resource can be imported using {{realm}}/{{locale}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmLocalizationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The locale of the texts, which has to be one of the realm's supported locales.",
+			},
+			"texts": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The texts of the locale, by message key. Texts that aren't listed are removed.",
+			},
+		},
+	}
+}
+
+func realmLocalizationId(realmId, locale string) string {
+	return realmId + "/" + locale
+}
+
+func getRealmLocalizationFromData(data *schema.ResourceData) *keycloak.RealmLocalization {
+	texts := map[string]string{}
+	for key, text := range data.Get("texts").(map[string]interface{}) {
+		texts[key] = text.(string)
+	}
+
+	return &keycloak.RealmLocalization{
+		RealmId: data.Get("realm_id").(string),
+		Locale:  data.Get("locale").(string),
+		Texts:   texts,
+	}
+}
+
+func setRealmLocalizationData(data *schema.ResourceData, localization *keycloak.RealmLocalization) {
+	data.SetId(realmLocalizationId(localization.RealmId, localization.Locale))
+	data.Set("realm_id", localization.RealmId)
+	data.Set("locale", localization.Locale)
+	data.Set("texts", localization.Texts)
+}
+
+func resourceKeycloakRealmLocalizationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	localization := getRealmLocalizationFromData(data)
+
+	err := keycloakClient.ValidateRealmLocalization(ctx, localization)
+	if err != nil {
+		return handleValidationError(err, nil)
+	}
+
+	err = keycloakClient.UpdateRealmLocalization(ctx, localization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmLocalizationId(localization.RealmId, localization.Locale))
+
+	return resourceKeycloakRealmLocalizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmLocalizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+
+	localization, err := keycloakClient.GetRealmLocalization(ctx, realmId, locale)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmLocalizationData(data, localization)
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	localization := getRealmLocalizationFromData(data)
+
+	err := keycloakClient.ValidateRealmLocalization(ctx, localization)
+	if err != nil {
+		return handleValidationError(err, nil)
+	}
+
+	err = keycloakClient.UpdateRealmLocalization(ctx, localization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmLocalizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmLocalizationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+
+	if err := keycloakClient.DeleteRealmLocalization(ctx, realmId, locale); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{locale}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("locale", parts[1])
+	d.SetId(realmLocalizationId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
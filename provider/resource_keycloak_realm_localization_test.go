@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmLocalization_basic(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmLocalizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, "de", map[string]string{"loginTitle": "Anmelden", "doLogIn": "Einloggen"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmLocalizationTexts("keycloak_realm_localization.german", map[string]string{"loginTitle": "Anmelden", "doLogIn": "Einloggen"}),
+					resource.TestCheckResourceAttr("keycloak_realm_localization.german", "id", realmName+"/de"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_localization.german",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/de",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmLocalization_update(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmLocalizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, "de", map[string]string{"loginTitle": "Anmelden", "doLogIn": "Einloggen"}),
+				Check:  testAccCheckKeycloakRealmLocalizationTexts("keycloak_realm_localization.german", map[string]string{"loginTitle": "Anmelden", "doLogIn": "Einloggen"}),
+			},
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, "de", map[string]string{"loginTitle": "Willkommen", "doRegister": "Registrieren"}),
+				Check:  testAccCheckKeycloakRealmLocalizationTexts("keycloak_realm_localization.german", map[string]string{"loginTitle": "Willkommen", "doRegister": "Registrieren"}),
+			},
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, "de", map[string]string{}),
+				Check:  testAccCheckKeycloakRealmLocalizationTexts("keycloak_realm_localization.german", map[string]string{}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmLocalization_unsupportedLocale(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmLocalizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmLocalization_basic(realmName, "nl", map[string]string{"loginTitle": "Inloggen"}),
+				ExpectError: regexp.MustCompile("locale nl is not one of the supported locales"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmLocalizationTexts(resourceName string, expectedTexts map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		locale := rs.Primary.Attributes["locale"]
+
+		localization, err := keycloakClient.GetRealmLocalization(testCtx, realmId, locale)
+		if err != nil {
+			return err
+		}
+
+		if len(localization.Texts) != len(expectedTexts) {
+			return fmt.Errorf("expected %d texts for locale %s, got %v", len(expectedTexts), locale, localization.Texts)
+		}
+
+		for key, text := range expectedTexts {
+			if localization.Texts[key] != text {
+				return fmt.Errorf("expected text %s to be %q, got %q", key, text, localization.Texts[key])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmLocalizationDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_localization" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			locale := rs.Primary.Attributes["locale"]
+
+			localization, _ := keycloakClient.GetRealmLocalization(testCtx, realmId, locale)
+			if localization != nil && len(localization.Texts) != 0 {
+				return fmt.Errorf("texts for locale %s still exist in realm %s", locale, realmId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmLocalization_basic(realmName, locale string, texts map[string]string) string {
+	textsHcl := ""
+	for key, text := range texts {
+		textsHcl += fmt.Sprintf("\t\t%s = \"%s\"\n", key, text)
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+
+	internationalization {
+		supported_locales = [
+			"en",
+			"de",
+			"fr",
+		]
+		default_locale    = "en"
+	}
+}
+
+resource "keycloak_realm_localization" "german" {
+	realm_id = keycloak_realm.realm.id
+	locale   = "%s"
+
+	texts = {
+%s	}
+}
+	`, realmName, locale, textsHcl)
+}
@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 
@@ -57,7 +59,7 @@ func TestAccKeycloakRealm_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteRealm(realmName)
+					err := keycloakClient.DeleteRealm(testCtx, realmName)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -92,6 +94,26 @@ func TestAccKeycloakRealm_import(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_timeouts(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_timeouts(realmName, "10m"),
+				Check:  testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+			},
+			{
+				Config:      testKeycloakRealm_timeouts(realmName, "1ns"),
+				ExpectError: regexp.MustCompile("context deadline exceeded"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_SmtpServer(t *testing.T) {
 	realm := acctest.RandomWithPrefix("tf-acc")
 	realmDisplayNameHtml := acctest.RandomWithPrefix("tf-acc")
@@ -590,6 +612,61 @@ func TestAccKeycloakRealm_passwordPolicy(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_passwordPolicySettings(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayNameHtml := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_passwordPolicySettings(realmName, 12, "notUsername = true"),
+				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "length(12) and upperCase(1) and notUsername"),
+			},
+			{
+				Config: testKeycloakRealm_passwordPolicySettings(realmName, 8, "password_history = 3"),
+				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "length(8) and upperCase(1) and passwordHistory(3)"),
+			},
+			{
+				Config: testKeycloakRealm_passwordPolicySettings(realmName, 12, "special_chars = 1\n\t\tnot_email = true\n\t\tregex = \"^[^ ]+$\""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "length(12) and upperCase(1) and specialChars(1) and notEmail and regexPattern(^[^ ]+$)"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy", ""),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy_settings.0.not_email", "true"),
+				),
+			},
+			// installed policies are accepted when planning
+			{
+				Config:             testKeycloakRealm_passwordPolicySettings(realmName, 12, "blacklist = \"passwords.txt\""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, "length(12) and unknownPolicy(1)"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("password-policy \"unknownPolicy\" does not exist"),
+			},
+			{
+				Config:      testKeycloakRealm_passwordPolicySettings(realmName, 8, "hash_algorithm = \"unknown\"\n\t\tblacklist = \"passwords.txt\""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("validation error: password-policy .+ does not exist on the server, installed providers: .+"),
+			},
+			// the terms of the string form can be in any order
+			{
+				Config: testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, "passwordHistory(3) and upperCase(1) and length(8)"),
+				Check:  testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+			},
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName, realmDisplayNameHtml),
+				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", ""),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_customAttribute(t *testing.T) {
 	realmName := acctest.RandomWithPrefix("tf-acc")
 	key := acctest.RandomWithPrefix("tf-acc")
@@ -665,7 +742,7 @@ func TestAccKeycloakRealm_internalId(t *testing.T) {
 				ImportState:   true,
 				Config:        testKeycloakRealm_basic(realmName, "foo", "<b>foo</b>"),
 				PreConfig: func() {
-					err := keycloakClient.NewRealm(realm)
+					err := keycloakClient.NewRealm(testCtx, realm)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -720,7 +797,7 @@ func TestAccKeycloakRealm_default_client_scopes_import(t *testing.T) {
 				ImportState:   true,
 				Config:        testKeycloakRealm_default_client_scopes(realmName, defaultDefaultClientScope, defaultOptionalClientScope),
 				PreConfig: func() {
-					err := keycloakClient.NewRealm(realm)
+					err := keycloakClient.NewRealm(testCtx, realm)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -749,7 +826,7 @@ func TestAccKeycloakRealm_default_client_scopes_import(t *testing.T) {
 				ImportState:   true,
 				Config:        testKeycloakRealm_default_client_scopes(realmName2, defaultDefaultClientScope2, defaultOptionalClientScope2),
 				PreConfig: func() {
-					err := keycloakClient.NewRealm(realm2)
+					err := keycloakClient.NewRealm(testCtx, realm2)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -862,6 +939,174 @@ func TestAccKeycloakRealm_webauthn(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_policies(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayNameHtml := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName, realmDisplayNameHtml),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "otp_policy.0.type", "totp"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "otp_policy.0.digits", "6"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.backchannel_token_delivery_mode", "poll"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_policies(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPolicies("keycloak_realm.realm"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "par_policy.0.request_uri_lifespan", "1m30s"),
+				),
+			},
+			// the policies are kept when the blocks are removed
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName, realmDisplayNameHtml),
+				Check:  testAccCheckKeycloakRealmPolicies("keycloak_realm.realm"),
+			},
+		},
+	})
+}
+
+// Policies that aren't configured are left alone, so setting some of them mustn't reset the others
+func TestAccKeycloakRealm_partialPolicies(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_partialPolicies(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPartialPolicies("keycloak_realm.realm"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "otp_policy.0.look_ahead_window", "0"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.backchannel_token_delivery_mode", "poll"),
+				),
+			},
+		},
+	})
+}
+
+// Keycloak versions that don't support organizations or fine-grained admin permissions reject realms that mention
+// them, so the requests the provider sends are checked, which is only possible with the in-memory server
+func TestAccKeycloakRealm_versionedSettings(t *testing.T) {
+	if testServer == nil {
+		t.Skip("the requests sent to Keycloak can only be checked with the in-memory Keycloak server")
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_basic(realmName, "foo", "<b>foo</b>"),
+				Check:  testAccCheckKeycloakRealmRequestsWithout(realmName, "organizationsEnabled", "adminPermissionsEnabled"),
+			},
+			// the settings are read back from the server, but aren't sent again unless they are changed
+			{
+				Config: testKeycloakRealm_basic(realmName, "bar", "<b>bar</b>"),
+				Check:  testAccCheckKeycloakRealmRequestsWithout(realmName, "organizationsEnabled", "adminPermissionsEnabled"),
+			},
+			{
+				Config:      testKeycloakRealm_organizationsEnabled(realmName, true),
+				ExpectError: regexp.MustCompile("organizations are only supported since Keycloak 25"),
+			},
+		},
+	})
+}
+
+// Checks that none of the representations of the realm that were sent to the in-memory server contain the given keys
+func testAccCheckKeycloakRealmRequestsWithout(realmName string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, request := range testServer.Requests() {
+			if request.Body == "" || (request.Method != http.MethodPost && request.Method != http.MethodPut) {
+				continue
+			}
+			if request.Path != "/admin/realms" && request.Path != "/admin/realms/"+realmName {
+				continue
+			}
+
+			var representation map[string]interface{}
+			if err := json.Unmarshal([]byte(request.Body), &representation); err != nil {
+				return fmt.Errorf("error parsing %s %s: %s", request.Method, request.Path, err)
+			}
+			if representation["realm"] != realmName {
+				continue
+			}
+
+			for _, key := range keys {
+				if _, ok := representation[key]; ok {
+					return fmt.Errorf("expected %s not to be sent with %s %s, got %s", key, request.Method, request.Path, request.Body)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmPolicies(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if realm.OtpPolicyType != "hotp" || realm.OtpPolicyAlgorithm != "HmacSHA256" || realm.OtpPolicyDigits != 8 || *realm.OtpPolicyInitialCounter != 2 {
+			return fmt.Errorf("unexpected otp policy of realm %s", realm.Realm)
+		}
+
+		if realm.CibaBackchannelTokenDeliveryMode != "ping" || realm.CibaExpiresIn != 300 || *realm.CibaInterval != 10 {
+			return fmt.Errorf("unexpected ciba policy of realm %s", realm.Realm)
+		}
+
+		if realm.OAuth2DeviceCodeLifespan != 900 || *realm.OAuth2DevicePollingInterval != 10 {
+			return fmt.Errorf("unexpected oauth2 device policy of realm %s", realm.Realm)
+		}
+
+		if realm.Attributes[realmParRequestUriLifespanAttribute] != "90" {
+			return fmt.Errorf("expected a par request uri lifespan of 90 seconds, got %v", realm.Attributes[realmParRequestUriLifespanAttribute])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmPartialPolicies(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if realm.OtpPolicyType != "hotp" || realm.OtpPolicyDigits != 8 || realm.OtpPolicyLookAheadWindow == nil || *realm.OtpPolicyLookAheadWindow != 0 {
+			return fmt.Errorf("unexpected otp policy of realm %s", realm.Realm)
+		}
+
+		if realm.OAuth2DeviceCodeLifespan != 900 {
+			return fmt.Errorf("expected a device code lifespan of 900 seconds, got %d", realm.OAuth2DeviceCodeLifespan)
+		}
+
+		if realm.CibaBackchannelTokenDeliveryMode != "poll" {
+			return fmt.Errorf("expected the ciba policy of realm %s to be left alone, got %s", realm.Realm, realm.CibaBackchannelTokenDeliveryMode)
+		}
+
+		return nil
+	}
+}
+
 func testKeycloakRealmLoginInfo(resourceName string, realm *keycloak.Realm) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		realmFromState, err := getRealmFromState(s, resourceName)
@@ -1045,7 +1290,7 @@ func testAccCheckKeycloakRealmDestroy() resource.TestCheckFunc {
 			}
 
 			realmName := rs.Primary.ID
-			realm, _ := keycloakClient.GetRealm(realmName)
+			realm, _ := keycloakClient.GetRealm(testCtx, realmName)
 			if realm != nil {
 				return fmt.Errorf("realm %s still exists", realmName)
 			}
@@ -1063,7 +1308,7 @@ func getRealmFromState(s *terraform.State, resourceName string) (*keycloak.Realm
 
 	realmName := rs.Primary.Attributes["realm"]
 
-	realm, err := keycloakClient.GetRealm(realmName)
+	realm, err := keycloakClient.GetRealm(testCtx, realmName)
 	if err != nil {
 		return nil, fmt.Errorf("error getting realm %s: %s", realmName, err)
 	}
@@ -1172,6 +1417,18 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, realmDisplayNameHtml)
 }
 
+func testKeycloakRealm_organizationsEnabled(realm string, organizationsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	enabled               = true
+	display_name          = "bar"
+	display_name_html     = "<b>bar</b>"
+	organizations_enabled = %t
+}
+	`, realm, organizationsEnabled)
+}
+
 func testKeycloakRealm_WithSmtpServer(realm, host, from, user string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1300,6 +1557,20 @@ resource "keycloak_realm" "realm" {
 	`, realm, realm, defaultLocale)
 }
 
+func testKeycloakRealm_timeouts(realm, timeout string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+
+	timeouts {
+		update = "%s"
+	}
+}
+	`, realm, timeout, timeout)
+}
+
 func testKeycloakRealm_notEnabled(realm, realmDisplayName string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1484,6 +1755,20 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, passwordPolicy)
 }
 
+func testKeycloakRealm_passwordPolicySettings(realm string, length int, extraSetting string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	password_policy_settings {
+		length     = %d
+		upper_case = 1
+		%s
+	}
+}
+	`, realm, length, extraSetting)
+}
+
 func testKeycloakRealm_browserFlow(realm, realmDisplayName, browserFlow string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1507,6 +1792,54 @@ resource "keycloak_realm" "realm" {
 	`, realm, key, value)
 }
 
+func testKeycloakRealm_policies(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	otp_policy {
+		type            = "hotp"
+		algorithm       = "HmacSHA256"
+		digits          = 8
+		initial_counter = 2
+	}
+
+	ciba_policy {
+		backchannel_token_delivery_mode = "ping"
+		expires_in                      = "5m"
+		interval                        = 10
+	}
+
+	oauth2_device_policy {
+		code_lifespan    = "15m"
+		polling_interval = 10
+	}
+
+	par_policy {
+		request_uri_lifespan = "90s"
+	}
+}
+	`, realm)
+}
+
+func testKeycloakRealm_partialPolicies(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	otp_policy {
+		type              = "hotp"
+		digits            = 8
+		look_ahead_window = 0
+	}
+
+	oauth2_device_policy {
+		code_lifespan = "15m"
+	}
+}
+	`, realm)
+}
+
 func testKeycloakRealm_webauthn_policy(realm, realmDisplayName, realmDisplayNameHtml, rpName, rpId, attestationConveyancePreference, authenticatorAttachment, requireResidentKey, userVerificationRequirement string, signatureAlgorithms []string, avoidSameAuthenticatorRegister bool) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmUserProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmUserProfileCreate,
+		ReadContext:   resourceKeycloakRealmUserProfileRead,
+		UpdateContext: resourceKeycloakRealmUserProfileUpdate,
+		DeleteContext: resourceKeycloakRealmUserProfileDelete,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmUserProfileImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"unmanaged_attribute_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "ADMIN_EDIT", "ADMIN_VIEW"}, false),
+				Description:  "How attributes that aren't defined in the profile are handled. They are disabled when this is empty.",
+			},
+			"attribute": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The attributes of the users, in the order they are displayed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"multi_valued": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enabled_when_scope": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "The attribute is only available when one of these scopes is requested.",
+						},
+						"required_for_roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+						"required_for_scopes": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+						"permissions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"view": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Optional: true,
+									},
+									"edit": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Optional: true,
+									},
+								},
+							},
+						},
+						"validator": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      realmUserProfileValidatorHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"config": {
+										Type:             schema.TypeMap,
+										Elem:             &schema.Schema{Type: schema.TypeString},
+										Optional:         true,
+										DiffSuppressFunc: suppressJsonDiff,
+									},
+								},
+							},
+						},
+						"annotations": {
+							Type:             schema.TypeMap,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							Optional:         true,
+							DiffSuppressFunc: suppressJsonDiff,
+						},
+					},
+				},
+			},
+			"group": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The groups attributes are displayed in.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"display_header": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"display_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"annotations": {
+							Type:             schema.TypeMap,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							Optional:         true,
+							DiffSuppressFunc: suppressJsonDiff,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Validators are hashed on their normalized configuration, so that formatting the JSON values differently doesn't
+// cause a diff
+func realmUserProfileValidatorHash(v interface{}) int {
+	validator := v.(map[string]interface{})
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", validator["name"].(string)))
+
+	config, _ := validator["config"].(map[string]interface{})
+
+	var keys []string
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		buf.WriteString(fmt.Sprintf("%s=%s-", key, normalizeJson(config[key].(string))))
+	}
+
+	return schema.HashString(buf.String())
+}
+
+// Annotations and validator configurations hold arbitrary JSON values. Strings are managed as they are, anything else
+// as its JSON encoding.
+func realmUserProfileValueToString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(encoded)
+}
+
+func realmUserProfileValueFromString(value string) interface{} {
+	if len(value) == 0 || (value[0] != '[' && value[0] != '{') {
+		return value
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	return decoded
+}
+
+func realmUserProfileValuesFromData(data map[string]interface{}) map[string]interface{} {
+	values := map[string]interface{}{}
+	for key, value := range data {
+		values[key] = realmUserProfileValueFromString(value.(string))
+	}
+
+	return values
+}
+
+func realmUserProfileValuesToData(values map[string]interface{}) map[string]string {
+	data := map[string]string{}
+	for key, value := range values {
+		data[key] = realmUserProfileValueToString(value)
+	}
+
+	return data
+}
+
+func getRealmUserProfileAttributeFromData(data map[string]interface{}) *keycloak.RealmUserProfileAttribute {
+	attribute := &keycloak.RealmUserProfileAttribute{
+		Name:        data["name"].(string),
+		DisplayName: data["display_name"].(string),
+		Group:       data["group"].(string),
+		Multivalued: data["multi_valued"].(bool),
+	}
+
+	if scopes := interfaceSliceToStringSlice(data["enabled_when_scope"].(*schema.Set).List()); len(scopes) != 0 {
+		attribute.Selector = &keycloak.RealmUserProfileSelector{Scopes: scopes}
+	}
+
+	roles := interfaceSliceToStringSlice(data["required_for_roles"].(*schema.Set).List())
+	scopes := interfaceSliceToStringSlice(data["required_for_scopes"].(*schema.Set).List())
+	if len(roles) != 0 || len(scopes) != 0 {
+		attribute.Required = &keycloak.RealmUserProfileRequired{Roles: roles, Scopes: scopes}
+	}
+
+	if permissions, ok := data["permissions"].([]interface{}); ok && len(permissions) == 1 && permissions[0] != nil {
+		permissionsData := permissions[0].(map[string]interface{})
+		attribute.Permissions = &keycloak.RealmUserProfilePermissions{
+			View: interfaceSliceToStringSlice(permissionsData["view"].(*schema.Set).List()),
+			Edit: interfaceSliceToStringSlice(permissionsData["edit"].(*schema.Set).List()),
+		}
+	}
+
+	if validators := data["validator"].(*schema.Set).List(); len(validators) != 0 {
+		attribute.Validations = map[string]map[string]interface{}{}
+		for _, v := range validators {
+			validator := v.(map[string]interface{})
+			attribute.Validations[validator["name"].(string)] = realmUserProfileValuesFromData(validator["config"].(map[string]interface{}))
+		}
+	}
+
+	if annotations := data["annotations"].(map[string]interface{}); len(annotations) != 0 {
+		attribute.Annotations = realmUserProfileValuesFromData(annotations)
+	}
+
+	return attribute
+}
+
+func getRealmUserProfileFromData(data *schema.ResourceData) *keycloak.RealmUserProfile {
+	userProfile := &keycloak.RealmUserProfile{
+		Attributes:               []*keycloak.RealmUserProfileAttribute{},
+		UnmanagedAttributePolicy: data.Get("unmanaged_attribute_policy").(string),
+	}
+
+	for _, a := range data.Get("attribute").([]interface{}) {
+		userProfile.Attributes = append(userProfile.Attributes, getRealmUserProfileAttributeFromData(a.(map[string]interface{})))
+	}
+
+	for _, g := range data.Get("group").([]interface{}) {
+		group := g.(map[string]interface{})
+
+		userProfileGroup := &keycloak.RealmUserProfileGroup{
+			Name:               group["name"].(string),
+			DisplayHeader:      group["display_header"].(string),
+			DisplayDescription: group["display_description"].(string),
+		}
+
+		if annotations := group["annotations"].(map[string]interface{}); len(annotations) != 0 {
+			userProfileGroup.Annotations = realmUserProfileValuesFromData(annotations)
+		}
+
+		userProfile.Groups = append(userProfile.Groups, userProfileGroup)
+	}
+
+	return userProfile
+}
+
+func getRealmUserProfileAttributeData(attribute *keycloak.RealmUserProfileAttribute) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":         attribute.Name,
+		"display_name": attribute.DisplayName,
+		"group":        attribute.Group,
+		"multi_valued": attribute.Multivalued,
+		"annotations":  realmUserProfileValuesToData(attribute.Annotations),
+	}
+
+	if attribute.Selector != nil {
+		data["enabled_when_scope"] = attribute.Selector.Scopes
+	}
+
+	if attribute.Required != nil {
+		// an empty requirement makes the attribute required for everyone
+		if len(attribute.Required.Roles) == 0 && len(attribute.Required.Scopes) == 0 {
+			data["required_for_roles"] = []string{"admin", "user"}
+		} else {
+			data["required_for_roles"] = attribute.Required.Roles
+			data["required_for_scopes"] = attribute.Required.Scopes
+		}
+	}
+
+	if attribute.Permissions != nil && (len(attribute.Permissions.View) != 0 || len(attribute.Permissions.Edit) != 0) {
+		data["permissions"] = []interface{}{
+			map[string]interface{}{
+				"view": attribute.Permissions.View,
+				"edit": attribute.Permissions.Edit,
+			},
+		}
+	}
+
+	var validators []interface{}
+	for name, config := range attribute.Validations {
+		validators = append(validators, map[string]interface{}{
+			"name":   name,
+			"config": realmUserProfileValuesToData(config),
+		})
+	}
+	data["validator"] = validators
+
+	return data
+}
+
+func setRealmUserProfileData(data *schema.ResourceData, realmId string, userProfile *keycloak.RealmUserProfile) {
+	data.SetId(realmId)
+	data.Set("realm_id", realmId)
+	data.Set("unmanaged_attribute_policy", userProfile.UnmanagedAttributePolicy)
+
+	var attributes []interface{}
+	for _, attribute := range userProfile.Attributes {
+		attributes = append(attributes, getRealmUserProfileAttributeData(attribute))
+	}
+	data.Set("attribute", attributes)
+
+	var groups []interface{}
+	for _, group := range userProfile.Groups {
+		groups = append(groups, map[string]interface{}{
+			"name":                group.Name,
+			"display_header":      group.DisplayHeader,
+			"display_description": group.DisplayDescription,
+			"annotations":         realmUserProfileValuesToData(group.Annotations),
+		})
+	}
+	data.Set("group", groups)
+}
+
+func resourceKeycloakRealmUserProfileCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userProfile := getRealmUserProfileFromData(data)
+
+	err := keycloakClient.ValidateRealmUserProfile(ctx, userProfile)
+	if err != nil {
+		return handleValidationError(err, nil)
+	}
+
+	err = keycloakClient.UpdateRealmUserProfile(ctx, realmId, userProfile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return resourceKeycloakRealmUserProfileRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	userProfile, err := keycloakClient.GetRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmUserProfileData(data, realmId, userProfile)
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userProfile := getRealmUserProfileFromData(data)
+
+	err := keycloakClient.ValidateRealmUserProfile(ctx, userProfile)
+	if err != nil {
+		return handleValidationError(err, nil)
+	}
+
+	err = keycloakClient.UpdateRealmUserProfile(ctx, realmId, userProfile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmUserProfileRead(ctx, data, meta)
+}
+
+// The user profile can't be removed from a realm, so it is reset to the one Keycloak creates for new realms
+func resourceKeycloakRealmUserProfileDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	if err := keycloakClient.UpdateRealmUserProfile(ctx, realmId, keycloakClient.DefaultRealmUserProfile()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
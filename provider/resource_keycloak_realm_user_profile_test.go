@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmUserProfile_basic(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmUserProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfile_basic(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileAttributes("keycloak_realm_user_profile.user_profile", "username", "email", "department"),
+					testAccCheckKeycloakRealmUserProfileDepartment("keycloak_realm_user_profile.user_profile"),
+					resource.TestCheckResourceAttr("keycloak_realm_user_profile.user_profile", "attribute.2.validator.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm_user_profile.user_profile", "attribute.2.annotations.inputType", "select"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_user_profile.user_profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmUserProfile_update(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmUserProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfile_basic(realmName),
+				Check:  testAccCheckKeycloakRealmUserProfileAttributes("keycloak_realm_user_profile.user_profile", "username", "email", "department"),
+			},
+			{
+				Config: testKeycloakRealmUserProfile_minimal(realmName),
+				Check:  testAccCheckKeycloakRealmUserProfileAttributes("keycloak_realm_user_profile.user_profile", "username", "email"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmUserProfile_undefinedGroup(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmUserProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmUserProfile_undefinedGroup(realmName),
+				ExpectError: regexp.MustCompile("attribute department belongs to group company, which isn't defined"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmUserProfileAttributes(resourceName string, expectedAttributes ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+
+		userProfile, err := keycloakClient.GetRealmUserProfile(testCtx, realmId)
+		if err != nil {
+			return err
+		}
+
+		if len(userProfile.Attributes) != len(expectedAttributes) {
+			return fmt.Errorf("expected %d attributes, got %d", len(expectedAttributes), len(userProfile.Attributes))
+		}
+
+		for i, name := range expectedAttributes {
+			if userProfile.Attributes[i].Name != name {
+				return fmt.Errorf("expected attribute %d to be %s, got %s", i, name, userProfile.Attributes[i].Name)
+			}
+		}
+
+		return nil
+	}
+}
+
+// Checks the options are sent as a JSON array rather than the string they're configured as, and that the requirement
+// and permissions are set
+func testAccCheckKeycloakRealmUserProfileDepartment(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		userProfile, err := keycloakClient.GetRealmUserProfile(testCtx, rs.Primary.Attributes["realm_id"])
+		if err != nil {
+			return err
+		}
+
+		department := userProfile.Attributes[2]
+		if options, ok := department.Validations["options"]["options"].([]interface{}); !ok || len(options) != 2 {
+			return fmt.Errorf("expected the options to be sent as a JSON array, got %#v", department.Validations["options"])
+		}
+		if department.Required == nil || len(department.Required.Roles) != 1 || department.Required.Roles[0] != "user" {
+			return fmt.Errorf("expected the department to be required for users, got %+v", department.Required)
+		}
+		if department.Permissions == nil || len(department.Permissions.Edit) != 1 || department.Permissions.Edit[0] != "admin" {
+			return fmt.Errorf("expected the department to be editable by admins only, got %+v", department.Permissions)
+		}
+
+		return nil
+	}
+}
+
+// The realms are deleted along with their user profile, and the shared test realm is reset
+func testAccCheckKeycloakRealmUserProfileDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_user_profile" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+
+			userProfile, _ := keycloakClient.GetRealmUserProfile(testCtx, realmId)
+			if userProfile != nil && len(userProfile.Attributes) > len(keycloakClient.DefaultRealmUserProfile().Attributes) {
+				return fmt.Errorf("user profile of realm %s still has %d attributes", realmId, len(userProfile.Attributes))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmUserProfile_basic(realmName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+}
+
+resource "keycloak_realm_user_profile" "user_profile" {
+	realm_id                   = keycloak_realm.realm.id
+	unmanaged_attribute_policy = "ADMIN_EDIT"
+
+	attribute {
+		name         = "username"
+		display_name = "$${username}"
+
+		permissions {
+			view = ["admin", "user"]
+			edit = ["admin", "user"]
+		}
+
+		validator {
+			name   = "length"
+			config = {
+				min = "3"
+				max = "255"
+			}
+		}
+	}
+
+	attribute {
+		name         = "email"
+		display_name = "$${email}"
+
+		required_for_roles = ["user"]
+
+		permissions {
+			view = ["admin", "user"]
+			edit = ["admin", "user"]
+		}
+
+		validator {
+			name = "email"
+		}
+	}
+
+	attribute {
+		name         = "department"
+		display_name = "Department"
+		group        = "company"
+
+		enabled_when_scope  = ["profile"]
+		required_for_roles  = ["user"]
+		required_for_scopes = ["profile"]
+
+		permissions {
+			view = ["admin", "user"]
+			edit = ["admin"]
+		}
+
+		validator {
+			name   = "options"
+			config = {
+				options = jsonencode(["sales", "engineering"])
+			}
+		}
+
+		annotations = {
+			inputType         = "select"
+			inputOptionLabels = jsonencode({ sales = "Sales", engineering = "Engineering" })
+		}
+	}
+
+	group {
+		name                = "company"
+		display_header      = "Company"
+		display_description = "Information about the user's employment"
+
+		annotations = {
+			collapsed = "false"
+		}
+	}
+}
+	`, realmName)
+}
+
+func testKeycloakRealmUserProfile_minimal(realmName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+}
+
+resource "keycloak_realm_user_profile" "user_profile" {
+	realm_id = keycloak_realm.realm.id
+
+	attribute {
+		name = "username"
+	}
+
+	attribute {
+		name = "email"
+	}
+}
+	`, realmName)
+}
+
+func testKeycloakRealmUserProfile_undefinedGroup(realmName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+}
+
+resource "keycloak_realm_user_profile" "user_profile" {
+	realm_id = keycloak_realm.realm.id
+
+	attribute {
+		name = "username"
+	}
+
+	attribute {
+		name = "email"
+	}
+
+	attribute {
+		name  = "department"
+		group = "company"
+	}
+}
+	`, realmName)
+}
@@ -1,24 +1,25 @@
 package provider
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakRoleCreate,
-		Read:   resourceKeycloakRoleRead,
-		Delete: resourceKeycloakRoleDelete,
-		Update: resourceKeycloakRoleUpdate,
+		CreateContext: resourceKeycloakRoleCreate,
+		ReadContext:   resourceKeycloakRoleRead,
+		DeleteContext: resourceKeycloakRoleDelete,
+		UpdateContext: resourceKeycloakRoleUpdate,
 		// This resource can be imported using {{realm}}/{{roleId}}. The role's ID (a GUID) can be found in the URL when viewing the role
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakRoleImport,
+			StateContext: resourceKeycloakRoleImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -89,7 +90,7 @@ func mapFromRoleToData(data *schema.ResourceData, role *keycloak.Role) {
 	data.Set("attributes", attributes)
 }
 
-func resourceKeycloakRoleCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakRoleCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	role := mapFromDataToRole(data)
@@ -99,9 +100,9 @@ func resourceKeycloakRoleCreate(data *schema.ResourceData, meta interface{}) err
 		compositeRolesTf := v.(*schema.Set).List()
 
 		for _, compositeRoleId := range compositeRolesTf {
-			compositeRoleToAdd, err := keycloakClient.GetRole(role.RealmId, compositeRoleId.(string))
+			compositeRoleToAdd, err := keycloakClient.GetRole(ctx, role.RealmId, compositeRoleId.(string))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			compositeRoles = append(compositeRoles, compositeRoleToAdd)
@@ -112,59 +113,53 @@ func resourceKeycloakRoleCreate(data *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	err := keycloakClient.CreateRole(role)
+	err := keycloakClient.CreateRole(ctx, role)
 	if err != nil {
-		var ae *keycloak.ApiError
-		if !errors.As(err, &ae) {
-			return err
-		}
-
-		if ae.Code != 409 {
-			return err
+		if !keycloak.ErrorIs409(err) {
+			return diag.FromErr(err)
 		}
 
 		log.Println("resource already exists, may be hardcoded, try to update")
-		r, err1 := keycloakClient.GetRoleByName(role.RealmId, role.ClientId, role.Name)
+		r, err1 := keycloakClient.GetRoleByName(ctx, role.RealmId, role.ClientId, role.Name)
 		if err1 != nil {
-			return err1
+			return diag.FromErr(err1)
 		}
 		data.SetId(r.Id)
 		role.Id = r.Id
-		err = resourceKeycloakRoleUpdate(data, meta)
-		if err != nil {
-			return err
+		if diags := resourceKeycloakRoleUpdate(ctx, data, meta); diags.HasError() {
+			return diags
 		}
 	}
 
 	if role.Composite {
-		err = keycloakClient.AddCompositesToRole(role, compositeRoles)
+		err = keycloakClient.AddCompositesToRole(ctx, role, compositeRoles)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	mapFromRoleToData(data, role)
 
-	return resourceKeycloakRoleRead(data, meta)
+	return resourceKeycloakRoleRead(ctx, data, meta)
 }
 
-func resourceKeycloakRoleRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakRoleRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	role, err := keycloakClient.GetRole(realmId, id)
+	role, err := keycloakClient.GetRole(ctx, realmId, id)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	mapFromRoleToData(data, role)
 
 	if role.Composite {
-		composites, err := keycloakClient.GetRoleComposites(role)
+		composites, err := keycloakClient.GetRoleComposites(ctx, role)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var compositeRoleIds []string
@@ -179,19 +174,19 @@ func resourceKeycloakRoleRead(data *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceKeycloakRoleUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakRoleUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	role := mapFromDataToRole(data)
 
-	err := keycloakClient.UpdateRole(role)
+	err := keycloakClient.UpdateRole(ctx, role)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	keycloakComposites, err := keycloakClient.GetRoleComposites(role)
+	keycloakComposites, err := keycloakClient.GetRoleComposites(ctx, role)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := data.GetOk("composite_roles"); ok {
@@ -214,51 +209,55 @@ func resourceKeycloakRoleUpdate(data *schema.ResourceData, meta interface{}) err
 		// `tfCompositeIds` should be added to the role's list of composites. all of the roles that exist on both sides have already been removed
 
 		if len(keycloakCompositesToRemove) != 0 {
-			err = keycloakClient.RemoveCompositesFromRole(role, keycloakCompositesToRemove)
+			err = keycloakClient.RemoveCompositesFromRole(ctx, role, keycloakCompositesToRemove)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		if tfCompositeIds.Len() != 0 {
 			var compositesToAdd []*keycloak.Role
 			for _, tfCompositeId := range tfCompositeIds.List() {
-				compositeToAdd, err := keycloakClient.GetRole(role.RealmId, tfCompositeId.(string))
+				compositeToAdd, err := keycloakClient.GetRole(ctx, role.RealmId, tfCompositeId.(string))
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				compositesToAdd = append(compositesToAdd, compositeToAdd)
 			}
 
-			err = keycloakClient.AddCompositesToRole(role, compositesToAdd)
+			err = keycloakClient.AddCompositesToRole(ctx, role, compositesToAdd)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	} else {
 		// the user wants this role to have zero composites. if there are composites attached, remove them
 		if len(keycloakComposites) != 0 {
-			err = keycloakClient.RemoveCompositesFromRole(role, keycloakComposites)
+			err = keycloakClient.RemoveCompositesFromRole(ctx, role, keycloakComposites)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceKeycloakRoleRead(data, meta)
+	return resourceKeycloakRoleRead(ctx, data, meta)
 }
 
-func resourceKeycloakRoleDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakRoleDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	return keycloakClient.DeleteRole(realmId, id)
+	if err := keycloakClient.DeleteRole(ctx, realmId, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakRoleImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakRoleImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 2 {
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+// The scope permissions of a realm or client role, keyed by the attribute they are configured by
+var rolePermissionsScopes = map[string]string{
+	"map_role_scope":              "map-role",
+	"map_role_composite_scope":    "map-role-composite",
+	"map_role_client_scope_scope": "map-role-client-scope",
+}
+
+func resourceKeycloakRolePermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRolePermissionsCreate,
+		ReadContext:   resourceKeycloakRolePermissionsRead,
+		DeleteContext: resourceKeycloakRolePermissionsDelete,
+		UpdateContext: resourceKeycloakRolePermissionsUpdate,
+		// This resource can be imported using {{realm}}/{{role_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRolePermissionsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"authorization_resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource server id representing the realm management client on which this permission is managed",
+			},
+			"map_role_scope":              scopePermissionsSchema(),
+			"map_role_composite_scope":    scopePermissionsSchema(),
+			"map_role_client_scope_scope": scopePermissionsSchema(),
+		},
+	}
+}
+
+func rolePermissionsId(realmId, roleId string) string {
+	return fmt.Sprintf("%s/%s", realmId, roleId)
+}
+
+func resourceKeycloakRolePermissionsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceKeycloakRolePermissionsUpdate(ctx, data, meta)
+}
+
+func resourceKeycloakRolePermissionsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+
+	// the existence of this resource implies that permissions are enabled for this role.
+	err := keycloakClient.EnableRolePermissions(ctx, realmId, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rolePermissions, err := keycloakClient.GetRolePermissions(ctx, realmId, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setScopePermissionPolicies(ctx, keycloakClient, data, realmId, realmManagementClient.Id, rolePermissions.ScopePermissions, rolePermissionsScopes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRolePermissionsRead(ctx, data, meta)
+}
+
+func resourceKeycloakRolePermissionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+
+	rolePermissions, err := keycloakClient.GetRolePermissions(ctx, realmId, roleId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if !rolePermissions.Enabled {
+		log.Printf("[WARN] Removing resource with id %s from state as it no longer enabled", data.Id())
+		data.SetId("")
+		return nil
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(rolePermissionsId(rolePermissions.RealmId, rolePermissions.RoleId))
+	data.Set("realm_id", rolePermissions.RealmId)
+	data.Set("role_id", rolePermissions.RoleId)
+	data.Set("enabled", rolePermissions.Enabled)
+	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+
+	err = getScopePermissionPolicies(ctx, keycloakClient, data, realmId, realmManagementClient.Id, rolePermissions.ScopePermissions, rolePermissionsScopes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRolePermissionsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+
+	if err := keycloakClient.DisableRolePermissions(ctx, realmId, roleId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRolePermissionsImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{roleId}}")
+	}
+	d.Set("realm_id", parts[0])
+	d.Set("role_id", parts[1])
+
+	d.SetId(rolePermissionsId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
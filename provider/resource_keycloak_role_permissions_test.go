@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRolePermissions_basic(t *testing.T) {
+	roleName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRolePermissions_basic(roleName, username),
+				Check:  testAccCheckKeycloakRolePermissionsMapRoleScope("keycloak_role_permissions.role"),
+			},
+			{
+				ResourceName:      "keycloak_role_permissions.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRolePermissions_deleted(roleName, username),
+				Check:  testAccCheckKeycloakRolePermissionsAreDisabled(roleName),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRolePermissionsMapRoleScope(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		roleId := rs.Primary.Attributes["role_id"]
+
+		permissions, err := keycloakClient.GetRolePermissions(testCtx, realmId, roleId)
+		if err != nil {
+			return fmt.Errorf("error getting role permissions with realm id %s and role id %s: %s", realmId, roleId, err)
+		}
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(testCtx, realmId, rs.Primary.Attributes["authorization_resource_server_id"], permissions.ScopePermissions["map-role"].(string))
+		if err != nil {
+			return err
+		}
+
+		if len(permission.Policies) != 1 || permission.Policies[0] != rs.Primary.Attributes["map_role_scope.0.policies.0"] {
+			return fmt.Errorf("expected map-role scope permission to have policy %s, got %v", rs.Primary.Attributes["map_role_scope.0.policies.0"], permission.Policies)
+		}
+		if permission.DecisionStrategy != "AFFIRMATIVE" {
+			return fmt.Errorf("expected map-role scope permission to have decision strategy AFFIRMATIVE, got %s", permission.DecisionStrategy)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRolePermissionsAreDisabled(roleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		role, err := keycloakClient.GetRoleByName(testCtx, testAccRealm.Realm, "", roleName)
+		if err != nil {
+			return err
+		}
+
+		permissions, err := keycloakClient.GetRolePermissions(testCtx, testAccRealm.Realm, role.Id)
+		if err != nil {
+			return fmt.Errorf("error getting role permissions with realm id %s and role id %s: %s", testAccRealm.Realm, role.Id, err)
+		}
+
+		if permissions.Enabled {
+			return fmt.Errorf("expected role permissions in Keycloak to be disabled")
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRolePermissions_deleted(roleName, username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_openid_client" "realm_management" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "realm-management"
+}
+
+resource "keycloak_openid_client_permissions" "realm_management_permission" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = data.keycloak_openid_client.realm_management.id
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user" "helpdesk" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_openid_client_user_policy" "helpdesk" {
+	realm_id           = data.keycloak_realm.realm.id
+	resource_server_id = data.keycloak_openid_client.realm_management.id
+
+	name  = "%s"
+	users = [
+		keycloak_user.helpdesk.id
+	]
+
+	logic             = "POSITIVE"
+	decision_strategy = "UNANIMOUS"
+
+	depends_on = [
+		keycloak_openid_client_permissions.realm_management_permission,
+	]
+}
+	`, testAccRealm.Realm, roleName, username, username)
+}
+
+func testKeycloakRolePermissions_basic(roleName, username string) string {
+	return testKeycloakRolePermissions_deleted(roleName, username) + `
+resource "keycloak_role_permissions" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	role_id  = keycloak_role.role.id
+
+	map_role_scope {
+		policies          = [
+			keycloak_openid_client_user_policy.helpdesk.id
+		]
+		decision_strategy = "AFFIRMATIVE"
+	}
+}
+	`
+}
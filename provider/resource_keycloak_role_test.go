@@ -230,7 +230,7 @@ func TestAccKeycloakRole_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteRole(role.RealmId, role.Id)
+					err := keycloakClient.DeleteRole(testCtx, role.RealmId, role.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -364,7 +364,7 @@ func testAccCheckKeycloakRoleDestroy() resource.TestCheckFunc {
 			id := rs.Primary.ID
 			realm := rs.Primary.Attributes["realm_id"]
 
-			role, _ := keycloakClient.GetRole(realm, id)
+			role, _ := keycloakClient.GetRole(testCtx, realm, id)
 			if role != nil {
 				return fmt.Errorf("role with id %s still exists", id)
 			}
@@ -420,7 +420,7 @@ func testAccCheckKeycloakRoleHasComposites(resourceName string, compositeRoleNam
 			return fmt.Errorf("expected role %s to have no composites, but has some", role.Name)
 		}
 
-		composites, err := keycloakClient.GetRoleComposites(role)
+		composites, err := keycloakClient.GetRoleComposites(testCtx, role)
 		if err != nil {
 			return err
 		}
@@ -466,7 +466,7 @@ func getRoleFromState(s *terraform.State, resourceName string) (*keycloak.Role,
 	id := rs.Primary.ID
 	realm := rs.Primary.Attributes["realm_id"]
 
-	role, err := keycloakClient.GetRole(realm, id)
+	role, err := keycloakClient.GetRole(testCtx, realm, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting role with id %s: %s", id, err)
 	}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+var samlAttributeToRoleIdentityProviderMapperConfigKeys = []string{"attribute.name", "attribute.friendly.name", "attribute.value", "role"}
+
+func resourceKeycloakSamlAttributeToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attribute_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Attribute Name",
+			ConflictsWith: []string{"attribute_friendly_name"},
+		},
+		"attribute_friendly_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Attribute Friendly Name",
+			ConflictsWith: []string{"attribute_name"},
+		},
+		"attribute_value": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Attribute Value",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name. Client roles are referenced as {{client_id}}.{{role_name}}",
+		},
+	}
+	return resourceKeycloakTypedIdentityProviderMapper(mapperSchema, samlAttributeToRoleIdentityProviderMapperConfigKeys, getSamlAttributeToRoleIdentityProviderMapperFromData, setSamlAttributeToRoleIdentityProviderMapperData)
+}
+
+func getSamlAttributeToRoleIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, identityProvider, err := getTypedIdentityProviderMapperFromData(ctx, data, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkIdentityProviderMapperSupported("SAML attribute to role", identityProvider, samlIdentityProviderIds); err != nil {
+		return nil, err
+	}
+
+	attributeName := data.Get("attribute_name").(string)
+	attributeFriendlyName := data.Get("attribute_friendly_name").(string)
+	if attributeName == "" && attributeFriendlyName == "" {
+		return nil, fmt.Errorf("one of attribute_name or attribute_friendly_name is required")
+	}
+
+	rec.IdentityProviderMapper = "saml-role-idp-mapper"
+	rec.Config["attribute.name"] = attributeName
+	rec.Config["attribute.friendly.name"] = attributeFriendlyName
+	rec.Config["attribute.value"] = data.Get("attribute_value").(string)
+	rec.Config["role"] = data.Get("role").(string)
+
+	return rec, nil
+}
+
+func setSamlAttributeToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setTypedIdentityProviderMapperData(data, identityProviderMapper, samlAttributeToRoleIdentityProviderMapperConfigKeys)
+	data.Set("attribute_name", getIdentityProviderMapperConfigString(identityProviderMapper, "attribute.name"))
+	data.Set("attribute_friendly_name", getIdentityProviderMapperConfigString(identityProviderMapper, "attribute.friendly.name"))
+	data.Set("attribute_value", getIdentityProviderMapperConfigString(identityProviderMapper, "attribute.value"))
+	data.Set("role", getIdentityProviderMapperConfigString(identityProviderMapper, "role"))
+	return nil
+}
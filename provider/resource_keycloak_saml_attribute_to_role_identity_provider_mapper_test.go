@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakSamlAttributeToRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_saml_attribute_to_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlAttributeToRoleIdentityProviderMapper_basic(alias, mapperName, "admin"),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_saml_attribute_to_role_identity_provider_mapper.saml", "saml-role-idp-mapper", "attribute.value", "admin"),
+			},
+			{
+				Config: testKeycloakSamlAttributeToRoleIdentityProviderMapper_basic(alias, mapperName, "administrator"),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_saml_attribute_to_role_identity_provider_mapper.saml", "saml-role-idp-mapper", "attribute.value", "administrator"),
+			},
+			{
+				ResourceName:        "keycloak_saml_attribute_to_role_identity_provider_mapper.saml",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlAttributeToRoleIdentityProviderMapper_unsupportedIdentityProvider(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_saml_attribute_to_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderMapper_withIdentityProvider("oidc", alias, "keycloak_saml_attribute_to_role_identity_provider_mapper", mapperName, `attribute_name  = "Role"
+	attribute_value = "admin"
+	role            = "offline_access"`),
+				ExpectError: regexp.MustCompile("not supported by identity provider .* which is of type oidc"),
+			},
+		},
+	})
+}
+
+func testKeycloakSamlAttributeToRoleIdentityProviderMapper_basic(alias, name, attributeValue string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_saml_attribute_to_role_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	attribute_name          = "Role"
+	attribute_value         = "%s"
+	role                    = "offline_access"
+}
+	`, testAccRealm.Realm, alias, name, attributeValue)
+}
@@ -55,7 +55,7 @@ func TestAccKeycloakSamlClient_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteSamlClient(client.RealmId, client.Id)
+					err := keycloakClient.DeleteSamlClient(testCtx, client.RealmId, client.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -416,7 +416,7 @@ func testAccCheckKeycloakSamlClientDestroy() resource.TestCheckFunc {
 			id := rs.Primary.ID
 			realm := rs.Primary.Attributes["realm_id"]
 
-			client, _ := keycloakClient.GetSamlClient(realm, id)
+			client, _ := keycloakClient.GetSamlClient(testCtx, realm, id)
 			if client != nil {
 				return fmt.Errorf("saml client %s still exists", id)
 			}
@@ -435,7 +435,7 @@ func getSamlClientFromState(s *terraform.State, resourceName string) (*keycloak.
 	id := rs.Primary.ID
 	realm := rs.Primary.Attributes["realm_id"]
 
-	client, err := keycloakClient.GetSamlClient(realm, id)
+	client, err := keycloakClient.GetSamlClient(testCtx, realm, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting saml client %s: %s", id, err)
 	}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakGithubIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_github_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGithubIdentityProvider_basic(alias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderConfig("keycloak_github_identity_provider.github", "github", "baseUrl", "https://github.example.com"),
+					testAccCheckKeycloakSocialIdentityProviderConfig("keycloak_github_identity_provider.github", "github", "apiUrl", "https://github.example.com/api/v3"),
+				),
+			},
+			{
+				ResourceName:            "keycloak_github_identity_provider.github",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGithubIdentityProvider_update(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_github_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_github_identity_provider", "github", alias, `base_url = "https://github.example.com"
+	extra_config = {
+		dummyConfig = "dummy"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderConfig("keycloak_github_identity_provider.github", "github", "baseUrl", "https://github.example.com"),
+					resource.TestCheckResourceAttr("keycloak_github_identity_provider.github", "provider_id", "github"),
+					resource.TestCheckResourceAttr("keycloak_github_identity_provider.github", "extra_config.%", "1"),
+					resource.TestCheckResourceAttr("keycloak_github_identity_provider.github", "extra_config.dummyConfig", "dummy"),
+				),
+			},
+			// settings that are emptied are removed from the config, so Keycloak falls back to github.com
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_github_identity_provider", "github", alias, `api_url = "https://github.example.com/api/v3"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderConfig("keycloak_github_identity_provider.github", "github", "apiUrl", "https://github.example.com/api/v3"),
+					testAccCheckKeycloakSocialIdentityProviderWithoutConfig("keycloak_github_identity_provider.github", "baseUrl"),
+				),
+			},
+		},
+	})
+}
+
+func TestKeycloakGithubIdentityProvider_extraConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	validateExtraConfig := resourceKeycloakGithubIdentityProvider().Schema["extra_config"].ValidateDiagFunc
+	for _, key := range []string{"baseUrl", "apiUrl", "clientId", "syncMode"} {
+		diags := validateExtraConfig(map[string]interface{}{key: "value"}, cty.Path{cty.GetAttrStep{Name: "extra_config"}})
+		if !diags.HasError() {
+			t.Errorf("expected extra_config key %s not to be allowed", key)
+		}
+	}
+}
+
+// The other social identity providers only differ in their provider specific settings, which are checked in the config
+// Keycloak stores them in
+func TestAccKeycloakSocialIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		resourceType string
+		providerId   string
+		attributes   string
+		configKey    string
+		configValue  string
+		// the provider isn't built into Keycloak, so the test only runs when this is set
+		requiredEnv string
+	}{
+		{
+			resourceType: "keycloak_microsoft_identity_provider",
+			providerId:   "microsoft",
+			attributes:   `tenant_id = "00000000-0000-0000-0000-000000000000"`,
+			configKey:    "tenantId",
+			configValue:  "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			resourceType: "keycloak_facebook_identity_provider",
+			providerId:   "facebook",
+			attributes:   `fetched_fields = "birthday,gender"`,
+			configKey:    "fetchedFields",
+			configValue:  "birthday,gender",
+		},
+		{
+			resourceType: "keycloak_gitlab_identity_provider",
+			providerId:   "gitlab",
+			attributes: `extra_config = {
+		dummyConfig = "dummy"
+	}`,
+			configKey:   "dummyConfig",
+			configValue: "dummy",
+		},
+		{
+			resourceType: "keycloak_linkedin_identity_provider",
+			providerId:   "linkedin",
+			attributes:   `profile_projection = "(id,firstName,lastName)"`,
+			configKey:    "profileProjection",
+			configValue:  "(id,firstName,lastName)",
+		},
+		{
+			resourceType: "keycloak_apple_identity_provider",
+			providerId:   "apple",
+			attributes: `team_id = "ABCDE12345"
+	key_id  = "FGHIJ67890"`,
+			configKey:   "teamId",
+			configValue: "ABCDE12345",
+			requiredEnv: "KEYCLOAK_TEST_APPLE_IDENTITY_PROVIDER",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.providerId, func(t *testing.T) {
+			if test.requiredEnv != "" && os.Getenv(test.requiredEnv) == "" {
+				t.Skipf("%s must be set to run the %s identity provider tests, as it requires an extension", test.requiredEnv, test.providerId)
+			}
+			t.Parallel()
+
+			alias := acctest.RandomWithPrefix("tf-acc")
+			resourceName := test.resourceType + "." + test.providerId
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy(test.resourceType),
+				Steps: []resource.TestStep{
+					{
+						Config: testKeycloakSocialIdentityProvider_basic(test.resourceType, test.providerId, alias, test.attributes),
+						Check:  testAccCheckKeycloakSocialIdentityProviderConfig(resourceName, test.providerId, test.configKey, test.configValue),
+					},
+					{
+						ResourceName:            resourceName,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateIdPrefix:     testAccRealm.Realm + "/",
+						ImportStateVerifyIgnore: []string{"client_secret"},
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderConfig(resourceName, providerId, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm"]
+		alias := rs.Primary.Attributes["alias"]
+
+		identityProvider, err := keycloakClient.GetIdentityProvider(testCtx, realm, alias)
+		if err != nil {
+			return fmt.Errorf("error getting identity provider with alias %s: %s", alias, err)
+		}
+
+		if identityProvider.ProviderId != providerId {
+			return fmt.Errorf("expected identity provider %s to have provider id %s, got %s", alias, providerId, identityProvider.ProviderId)
+		}
+		if identityProvider.Config.ExtraConfig[key] != value {
+			return fmt.Errorf("expected identity provider %s to have config %s with value %s, got %v", alias, key, value, identityProvider.Config.ExtraConfig[key])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderWithoutConfig(resourceName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm"]
+		alias := rs.Primary.Attributes["alias"]
+
+		identityProvider, err := keycloakClient.GetIdentityProvider(testCtx, realm, alias)
+		if err != nil {
+			return fmt.Errorf("error getting identity provider with alias %s: %s", alias, err)
+		}
+
+		if value, ok := identityProvider.Config.ExtraConfig[key]; ok {
+			return fmt.Errorf("expected identity provider %s not to have config %s, got %v", alias, key, value)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm"]
+
+			idp, _ := keycloakClient.GetIdentityProvider(testCtx, realm, id)
+			if idp != nil {
+				return fmt.Errorf("identity provider with alias %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakGithubIdentityProvider_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_github_identity_provider" "github" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+	base_url      = "https://github.example.com"
+	api_url       = "https://github.example.com/api/v3"
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testKeycloakSocialIdentityProvider_basic(resourceType, name, alias, attributes string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "%s" "%s" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+
+	%s
+}
+	`, testAccRealm.Realm, resourceType, name, alias, attributes)
+}
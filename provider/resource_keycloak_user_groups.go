@@ -1,22 +1,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakUserGroups() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakUserGroupsReconcile,
-		Read:   resourceKeycloakUserGroupsRead,
-		Delete: resourceKeycloakUserGroupsDelete,
-		Update: resourceKeycloakUserGroupsReconcile,
+		CreateContext: resourceKeycloakUserGroupsReconcile,
+		ReadContext:   resourceKeycloakUserGroupsRead,
+		DeleteContext: resourceKeycloakUserGroupsDelete,
+		UpdateContext: resourceKeycloakUserGroupsReconcile,
 		// This resource can be imported using {{realm}}/{{userId}}.
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakUserGroupsImport,
+			StateContext: resourceKeycloakUserGroupsImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -44,7 +46,7 @@ func resourceKeycloakUserGroups() *schema.Resource {
 	}
 }
 
-func resourceKeycloakUserGroupsRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUserGroupsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -52,9 +54,9 @@ func resourceKeycloakUserGroupsRead(data *schema.ResourceData, meta interface{})
 	groupIds := data.Get("group_ids").(*schema.Set)
 	exhaustive := data.Get("exhaustive").(bool)
 
-	userGroups, err := keycloakClient.GetUserGroups(realmId, userId)
+	userGroups, err := keycloakClient.GetUserGroups(ctx, realmId, userId)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	var groups []string
@@ -71,7 +73,7 @@ func resourceKeycloakUserGroupsRead(data *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceKeycloakUserGroupsReconcile(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUserGroupsReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -85,14 +87,14 @@ func resourceKeycloakUserGroupsReconcile(data *schema.ResourceData, meta interfa
 		ns := n.(*schema.Set)
 		remove := interfaceSliceToStringSlice(os.Difference(ns).List())
 
-		if err := keycloakClient.RemoveUserFromGroups(remove, userId, realmId); err != nil {
-			return err
+		if err := keycloakClient.RemoveUserFromGroups(ctx, remove, userId, realmId); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	userGroups, err := keycloakClient.GetUserGroups(realmId, userId)
+	userGroups, err := keycloakClient.GetUserGroups(ctx, realmId, userId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var userGroupsIds []string
@@ -103,31 +105,35 @@ func resourceKeycloakUserGroupsReconcile(data *schema.ResourceData, meta interfa
 	remove := stringArrayDifference(userGroupsIds, groupIds)
 	add := stringArrayDifference(groupIds, userGroupsIds)
 
-	if err := keycloakClient.AddUserToGroups(add, userId, realmId); err != nil {
-		return err
+	if err := keycloakClient.AddUserToGroups(ctx, add, userId, realmId); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if exhaustive {
-		if err := keycloakClient.RemoveUserFromGroups(remove, userId, realmId); err != nil {
-			return err
+		if err := keycloakClient.RemoveUserFromGroups(ctx, remove, userId, realmId); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	data.SetId(userGroupsId(realmId, userId))
-	return resourceKeycloakUserGroupsRead(data, meta)
+	return resourceKeycloakUserGroupsRead(ctx, data, meta)
 }
 
-func resourceKeycloakUserGroupsDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUserGroupsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	userId := data.Get("user_id").(string)
 	groupIds := interfaceSliceToStringSlice(data.Get("group_ids").(*schema.Set).List())
 
-	return keycloakClient.RemoveUserFromGroups(groupIds, userId, realmId)
+	if err := keycloakClient.RemoveUserFromGroups(ctx, groupIds, userId, realmId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakUserGroupsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakUserGroupsImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 2 {
@@ -248,7 +248,7 @@ func testAccCheckKeycloakUserHasGroups(resourceName string) resource.TestCheckFu
 				continue
 			}
 
-			group, err := keycloakClient.GetGroup(realm, v)
+			group, err := keycloakClient.GetGroup(testCtx, realm, v)
 			if err != nil {
 				return err
 			}
@@ -256,7 +256,7 @@ func testAccCheckKeycloakUserHasGroups(resourceName string) resource.TestCheckFu
 			expectedGroups = append(expectedGroups, group)
 		}
 
-		userGroups, err := keycloakClient.GetUserGroups(realm, userId)
+		userGroups, err := keycloakClient.GetUserGroups(testCtx, realm, userId)
 		if err != nil {
 			return err
 		}
@@ -301,7 +301,7 @@ func testAccCheckKeycloakUserHasNonExhaustiveGroups(resourceName string) resourc
 				continue
 			}
 
-			group, err := keycloakClient.GetGroup(realm, v)
+			group, err := keycloakClient.GetGroup(testCtx, realm, v)
 			if err != nil {
 				return err
 			}
@@ -309,7 +309,7 @@ func testAccCheckKeycloakUserHasNonExhaustiveGroups(resourceName string) resourc
 			expectedGroups = append(expectedGroups, group)
 		}
 
-		userGroups, err := keycloakClient.GetUserGroups(realm, userId)
+		userGroups, err := keycloakClient.GetUserGroups(testCtx, realm, userId)
 		if err != nil {
 			return err
 		}
@@ -348,7 +348,7 @@ func testAccCheckKeycloakUserHasNoGroups(resourceName string) resource.TestCheck
 		realm := rs.Primary.Attributes["realm_id"]
 		id := rs.Primary.ID
 
-		userGroups, err := keycloakClient.GetUserGroups(realm, id)
+		userGroups, err := keycloakClient.GetUserGroups(testCtx, realm, id)
 		if err != nil {
 			return err
 		}
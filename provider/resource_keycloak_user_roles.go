@@ -1,22 +1,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakUserRoles() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakUserRolesReconcile,
-		Read:   resourceKeycloakUserRolesRead,
-		Update: resourceKeycloakUserRolesReconcile,
-		Delete: resourceKeycloakUserRolesDelete,
+		CreateContext: resourceKeycloakUserRolesReconcile,
+		ReadContext:   resourceKeycloakUserRolesRead,
+		UpdateContext: resourceKeycloakUserRolesReconcile,
+		DeleteContext: resourceKeycloakUserRolesDelete,
 		// This resource can be imported using {{realm}}/{{userId}}.
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakUserRolesImport,
+			StateContext: resourceKeycloakUserRolesImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -48,9 +50,9 @@ func userRolesId(realmId, userId string) string {
 	return fmt.Sprintf("%s/%s", realmId, userId)
 }
 
-func addRolesToUser(keycloakClient *keycloak.KeycloakClient, clientRolesToAdd map[string][]*keycloak.Role, realmRolesToAdd []*keycloak.Role, user *keycloak.User) error {
+func addRolesToUser(ctx context.Context, keycloakClient *keycloak.KeycloakClient, clientRolesToAdd map[string][]*keycloak.Role, realmRolesToAdd []*keycloak.Role, user *keycloak.User) error {
 	if len(realmRolesToAdd) != 0 {
-		err := keycloakClient.AddRealmRolesToUser(user.RealmId, user.Id, realmRolesToAdd)
+		err := keycloakClient.AddRealmRolesToUser(ctx, user.RealmId, user.Id, realmRolesToAdd)
 		if err != nil {
 			return err
 		}
@@ -58,7 +60,7 @@ func addRolesToUser(keycloakClient *keycloak.KeycloakClient, clientRolesToAdd ma
 
 	for k, roles := range clientRolesToAdd {
 		if len(roles) != 0 {
-			err := keycloakClient.AddClientRolesToUser(user.RealmId, user.Id, k, roles)
+			err := keycloakClient.AddClientRolesToUser(ctx, user.RealmId, user.Id, k, roles)
 			if err != nil {
 				return err
 			}
@@ -68,9 +70,9 @@ func addRolesToUser(keycloakClient *keycloak.KeycloakClient, clientRolesToAdd ma
 	return nil
 }
 
-func removeRolesFromUser(keycloakClient *keycloak.KeycloakClient, clientRolesToRemove map[string][]*keycloak.Role, realmRolesToRemove []*keycloak.Role, user *keycloak.User) error {
+func removeRolesFromUser(ctx context.Context, keycloakClient *keycloak.KeycloakClient, clientRolesToRemove map[string][]*keycloak.Role, realmRolesToRemove []*keycloak.Role, user *keycloak.User) error {
 	if len(realmRolesToRemove) != 0 {
-		err := keycloakClient.RemoveRealmRolesFromUser(user.RealmId, user.Id, realmRolesToRemove)
+		err := keycloakClient.RemoveRealmRolesFromUser(ctx, user.RealmId, user.Id, realmRolesToRemove)
 		if err != nil {
 			return err
 		}
@@ -78,7 +80,7 @@ func removeRolesFromUser(keycloakClient *keycloak.KeycloakClient, clientRolesToR
 
 	for k, roles := range clientRolesToRemove {
 		if len(roles) != 0 {
-			err := keycloakClient.RemoveClientRolesFromUser(user.RealmId, user.Id, k, roles)
+			err := keycloakClient.RemoveClientRolesFromUser(ctx, user.RealmId, user.Id, k, roles)
 			if err != nil {
 				return err
 			}
@@ -88,7 +90,7 @@ func removeRolesFromUser(keycloakClient *keycloak.KeycloakClient, clientRolesToR
 	return nil
 }
 
-func resourceKeycloakUserRolesReconcile(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUserRolesReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -96,9 +98,9 @@ func resourceKeycloakUserRolesReconcile(data *schema.ResourceData, meta interfac
 	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
 	exhaustive := data.Get("exhaustive").(bool)
 
-	user, err := keycloakClient.GetUser(realmId, userId)
+	user, err := keycloakClient.GetUser(ctx, realmId, userId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if data.HasChange("role_ids") {
@@ -107,47 +109,47 @@ func resourceKeycloakUserRolesReconcile(data *schema.ResourceData, meta interfac
 		ns := n.(*schema.Set)
 		remove := interfaceSliceToStringSlice(os.Difference(ns).List())
 
-		tfRolesToRemove, err := getExtendedRoleMapping(keycloakClient, realmId, remove)
+		tfRolesToRemove, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, remove)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		if err = removeRolesFromUser(keycloakClient, tfRolesToRemove.clientRoles, tfRolesToRemove.realmRoles, user); err != nil {
-			return err
+		if err = removeRolesFromUser(ctx, keycloakClient, tfRolesToRemove.clientRoles, tfRolesToRemove.realmRoles, user); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	tfRoles, err := getExtendedRoleMapping(keycloakClient, realmId, roleIds)
+	tfRoles, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// get the list of currently assigned roles. Due to default realm and client roles
 	// (e.g. roles of the account client) this is probably not empty upon resource creation
-	roleMappings, err := keycloakClient.GetUserRoleMappings(realmId, userId)
+	roleMappings, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
 
 	// sort into roles we need to add and roles we need to remove
 	updates := calculateRoleMappingUpdates(tfRoles, intoRoleMapping(roleMappings))
 
 	// add roles
-	err = addRolesToUser(keycloakClient, updates.clientRolesToAdd, updates.realmRolesToAdd, user)
+	err = addRolesToUser(ctx, keycloakClient, updates.clientRolesToAdd, updates.realmRolesToAdd, user)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// remove roles if exhaustive (authoritative)
 	if exhaustive {
-		err = removeRolesFromUser(keycloakClient, updates.clientRolesToRemove, updates.realmRolesToRemove, user)
+		err = removeRolesFromUser(ctx, keycloakClient, updates.clientRolesToRemove, updates.realmRolesToRemove, user)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	data.SetId(userRolesId(realmId, userId))
-	return resourceKeycloakUserRolesRead(data, meta)
+	return resourceKeycloakUserRolesRead(ctx, data, meta)
 }
 
-func resourceKeycloakUserRolesRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUserRolesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -156,13 +158,13 @@ func resourceKeycloakUserRolesRead(data *schema.ResourceData, meta interface{})
 	exhaustive := data.Get("exhaustive").(bool)
 
 	// check if user exists, remove from state if not found
-	if _, err := keycloakClient.GetUser(realmId, userId); err != nil {
-		return handleNotFoundError(err, data)
+	if _, err := keycloakClient.GetUser(ctx, realmId, userId); err != nil {
+		return handleNotFoundError(ctx, err, data)
 	}
 
-	roles, err := keycloakClient.GetUserRoleMappings(realmId, userId)
+	roles, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var roleIds []string
@@ -187,29 +189,29 @@ func resourceKeycloakUserRolesRead(data *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceKeycloakUserRolesDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUserRolesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	userId := data.Get("user_id").(string)
 
-	user, err := keycloakClient.GetUser(realmId, userId)
+	user, err := keycloakClient.GetUser(ctx, realmId, userId)
 
 	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
-	rolesToRemove, err := getExtendedRoleMapping(keycloakClient, realmId, roleIds)
+	rolesToRemove, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = removeRolesFromUser(keycloakClient, rolesToRemove.clientRoles, rolesToRemove.realmRoles, user)
+	err = removeRolesFromUser(ctx, keycloakClient, rolesToRemove.clientRoles, rolesToRemove.realmRoles, user)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceKeycloakUserRolesImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakUserRolesImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 2 {
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+)
+
+var userTemplateImporterIdentityProviderMapperConfigKeys = []string{"template", "target"}
+
+var userTemplateImporterTargets = []string{
+	"LOCAL",
+	"BROKER_ID",
+	"BROKER_USERNAME",
+}
+
+func resourceKeycloakUserTemplateImporterIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"template": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Username Template, such as ${ALIAS}.${CLAIM.email}",
+		},
+		"target": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "LOCAL",
+			ValidateFunc: validation.StringInSlice(userTemplateImporterTargets, false),
+			Description:  "Destination field for the formatted username",
+		},
+	}
+	return resourceKeycloakTypedIdentityProviderMapper(mapperSchema, userTemplateImporterIdentityProviderMapperConfigKeys, getUserTemplateImporterIdentityProviderMapperFromData, setUserTemplateImporterIdentityProviderMapperData)
+}
+
+func getUserTemplateImporterIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, identityProvider, err := getTypedIdentityProviderMapperFromData(ctx, data, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkIdentityProviderMapperSupported("Username template importer", identityProvider, append(oidcIdentityProviderIds, samlIdentityProviderIds...)); err != nil {
+		return nil, err
+	}
+
+	if stringSliceContains(samlIdentityProviderIds, identityProvider.ProviderId) {
+		rec.IdentityProviderMapper = "saml-username-idp-mapper"
+	} else {
+		rec.IdentityProviderMapper = "oidc-username-idp-mapper"
+	}
+	rec.Config["template"] = data.Get("template").(string)
+	rec.Config["target"] = data.Get("target").(string)
+
+	return rec, nil
+}
+
+func setUserTemplateImporterIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setTypedIdentityProviderMapperData(data, identityProviderMapper, userTemplateImporterIdentityProviderMapperConfigKeys)
+
+	// mappers created before the target could be chosen don't have one, and always set the local username
+	target := getIdentityProviderMapperConfigString(identityProviderMapper, "target")
+	if target == "" {
+		target = "LOCAL"
+	}
+
+	data.Set("template", getIdentityProviderMapperConfigString(identityProviderMapper, "template"))
+	data.Set("target", target)
+	return nil
+}
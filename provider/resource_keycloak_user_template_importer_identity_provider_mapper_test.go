@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakUserTemplateImporterIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_user_template_importer_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserTemplateImporterIdentityProviderMapper_basic(alias, mapperName, "LOCAL"),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_user_template_importer_identity_provider_mapper.oidc", "oidc-username-idp-mapper", "target", "LOCAL"),
+			},
+			{
+				Config: testKeycloakUserTemplateImporterIdentityProviderMapper_basic(alias, mapperName, "BROKER_USERNAME"),
+				Check:  testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_user_template_importer_identity_provider_mapper.oidc", "oidc-username-idp-mapper", "target", "BROKER_USERNAME"),
+			},
+			{
+				ResourceName:        "keycloak_user_template_importer_identity_provider_mapper.oidc",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserTemplateImporterIdentityProviderMapper_providerIds(t *testing.T) {
+	t.Parallel()
+
+	for providerId, expectedMapperType := range map[string]string{
+		"oidc":          "oidc-username-idp-mapper",
+		"keycloak-oidc": "oidc-username-idp-mapper",
+		"saml":          "saml-username-idp-mapper",
+		"github":        "",
+	} {
+		providerId, expectedMapperType := providerId, expectedMapperType
+		t.Run(providerId, func(t *testing.T) {
+			t.Parallel()
+			alias := acctest.RandomWithPrefix("tf-acc")
+			mapperName := acctest.RandomWithPrefix("tf-acc")
+
+			step := resource.TestStep{
+				Config: testKeycloakIdentityProviderMapper_withIdentityProvider(providerId, alias, "keycloak_user_template_importer_identity_provider_mapper", mapperName, `template = "$${ALIAS}.$${CLAIM.email}"`),
+			}
+			if expectedMapperType == "" {
+				step.ExpectError = regexp.MustCompile("Username template importer identity provider mappers are not supported")
+			} else {
+				step.Check = testAccCheckKeycloakIdentityProviderMapperConfig("keycloak_user_template_importer_identity_provider_mapper.mapper", expectedMapperType, "target", "LOCAL")
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_user_template_importer_identity_provider_mapper"),
+				Steps:             []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+func testKeycloakUserTemplateImporterIdentityProviderMapper_basic(alias, name, target string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_user_template_importer_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	template                = "$${ALIAS}.$${CLAIM.email}"
+	target                  = "%s"
+}
+	`, testAccRealm.Realm, alias, name, target)
+}
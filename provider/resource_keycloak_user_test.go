@@ -90,7 +90,7 @@ func TestAccKeycloakUser_createAfterManualDestroy(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					err := keycloakClient.DeleteUser(user.RealmId, user.Id)
+					err := keycloakClient.DeleteUser(testCtx, user.RealmId, user.Id)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -264,6 +264,23 @@ func TestAccKeycloakUser_validateLowercaseUsernames(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_duplicateUsername(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUser_duplicateUsername(username),
+				ExpectError: regexp.MustCompile("user with username " + username + " already exists"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakUser_federatedLink(t *testing.T) {
 	sourceUserName := acctest.RandomWithPrefix("tf-acc")
 	sourceUserName2 := acctest.RandomWithPrefix("tf-acc")
@@ -381,7 +398,7 @@ func testAccCheckKeycloakUserDestroy() resource.TestCheckFunc {
 			id := rs.Primary.ID
 			realm := rs.Primary.Attributes["realm_id"]
 
-			user, _ := keycloakClient.GetUser(realm, id)
+			user, _ := keycloakClient.GetUser(testCtx, realm, id)
 			if user != nil {
 				return fmt.Errorf("user with id %s still exists", id)
 			}
@@ -400,7 +417,7 @@ func getUserFromState(s *terraform.State, resourceName string) (*keycloak.User,
 	id := rs.Primary.ID
 	realm := rs.Primary.Attributes["realm_id"]
 
-	user, err := keycloakClient.GetUser(realm, id)
+	user, err := keycloakClient.GetUser(testCtx, realm, id)
 	if err != nil {
 		return nil, fmt.Errorf("error getting user with id %s: %s", id, err)
 	}
@@ -424,6 +441,25 @@ resource "keycloak_user" "user" {
 	`, testAccRealm.Realm, username, attributeName, attributeValue)
 }
 
+func testKeycloakUser_duplicateUsername(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user" "duplicate" {
+	realm_id   = data.keycloak_realm.realm.id
+	username   = "%s"
+	depends_on = [keycloak_user.user]
+}
+	`, testAccRealm.Realm, username, username)
+}
+
 func testKeycloakUser_initialPassword(username string, password string, clientId string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
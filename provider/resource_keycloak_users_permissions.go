@@ -1,18 +1,21 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
 
 func resourceKeycloakUsersPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKeycloakUsersPermissionsCreate,
-		Read:   resourceKeycloakUsersPermissionsRead,
-		Delete: resourceKeycloakUsersPermissionsDelete,
-		Update: resourceKeycloakUsersPermissionsUpdate,
+		CreateContext: resourceKeycloakUsersPermissionsCreate,
+		ReadContext:   resourceKeycloakUsersPermissionsRead,
+		DeleteContext: resourceKeycloakUsersPermissionsDelete,
+		UpdateContext: resourceKeycloakUsersPermissionsUpdate,
 		Importer: &schema.ResourceImporter{
-			State: resourceKeycloakUsersPermissionsImport,
+			StateContext: resourceKeycloakUsersPermissionsImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -39,84 +42,84 @@ func resourceKeycloakUsersPermissions() *schema.Resource {
 	}
 }
 
-func resourceKeycloakUsersPermissionsCreate(data *schema.ResourceData, meta interface{}) error {
-	return resourceKeycloakUsersPermissionsUpdate(data, meta)
+func resourceKeycloakUsersPermissionsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceKeycloakUsersPermissionsUpdate(ctx, data, meta)
 }
 
-func resourceKeycloakUsersPermissionsUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUsersPermissionsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 
 	// the existence of this resource implies that it is enabled.
-	err := keycloakClient.EnableUsersPermissions(realmId)
+	err := keycloakClient.EnableUsersPermissions(ctx, realmId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// setting scope permissions requires us to fetch the users permissions details, as well as the realm management client
-	usersPermissions, err := keycloakClient.GetUsersPermissions(realmId)
+	usersPermissions, err := keycloakClient.GetUsersPermissions(ctx, realmId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(realmId, "realm-management")
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if viewScope, ok := data.GetOk("view_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["view"].(string), viewScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["view"].(string), viewScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if manageScope, ok := data.GetOk("manage_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["manage"].(string), manageScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["manage"].(string), manageScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if mapRolesScope, ok := data.GetOk("map_roles_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["map-roles"].(string), mapRolesScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["map-roles"].(string), mapRolesScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if manageGroupMembershipScope, ok := data.GetOk("manage_group_membership_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["manage-group-membership"].(string), manageGroupMembershipScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["manage-group-membership"].(string), manageGroupMembershipScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if impersonateScope, ok := data.GetOk("impersonate_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["impersonate"].(string), impersonateScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["impersonate"].(string), impersonateScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if userImpersonatedScope, ok := data.GetOk("user_impersonated_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["user-impersonated"].(string), userImpersonatedScope.(*schema.Set))
+		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["user-impersonated"].(string), userImpersonatedScope.(*schema.Set))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceKeycloakUsersPermissionsRead(data, meta)
+	return resourceKeycloakUsersPermissionsRead(ctx, data, meta)
 }
 
-func resourceKeycloakUsersPermissionsRead(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUsersPermissionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	realmId := data.Get("realm_id").(string)
 
-	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(realmId, "realm-management")
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	usersPermissions, err := keycloakClient.GetUsersPermissions(realmId)
+	usersPermissions, err := keycloakClient.GetUsersPermissions(ctx, realmId)
 	if err != nil {
-		return handleNotFoundError(err, data)
+		return handleNotFoundError(ctx, err, data)
 	}
 
 	data.SetId(usersPermissions.RealmId)
@@ -124,54 +127,58 @@ func resourceKeycloakUsersPermissionsRead(data *schema.ResourceData, meta interf
 	data.Set("enabled", usersPermissions.Enabled)
 	data.Set("authorization_resource_server_id", realmManagementClient.Id)
 
-	if viewScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["view"].(string)); err == nil && viewScope != nil {
+	if viewScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["view"].(string)); err == nil && viewScope != nil {
 		data.Set("view_scope", []interface{}{viewScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if manageScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["manage"].(string)); err == nil && manageScope != nil {
+	if manageScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["manage"].(string)); err == nil && manageScope != nil {
 		data.Set("manage_scope", []interface{}{manageScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if mapRolesScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["map-roles"].(string)); err == nil && mapRolesScope != nil {
+	if mapRolesScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["map-roles"].(string)); err == nil && mapRolesScope != nil {
 		data.Set("map_roles_scope", []interface{}{mapRolesScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if manageGroupMembershipScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["manage-group-membership"].(string)); err == nil && manageGroupMembershipScope != nil {
+	if manageGroupMembershipScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["manage-group-membership"].(string)); err == nil && manageGroupMembershipScope != nil {
 		data.Set("manage_group_membership_scope", []interface{}{manageGroupMembershipScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if impersonateScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["impersonate"].(string)); err == nil && impersonateScope != nil {
+	if impersonateScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["impersonate"].(string)); err == nil && impersonateScope != nil {
 		data.Set("impersonate_scope", []interface{}{impersonateScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if userImpersonatedScope, err := getOpenidClientScopePermissionPolicy(keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["user-impersonated"].(string)); err == nil && userImpersonatedScope != nil {
+	if userImpersonatedScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions["user-impersonated"].(string)); err == nil && userImpersonatedScope != nil {
 		data.Set("user_impersonated_scope", []interface{}{userImpersonatedScope})
 	} else if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceKeycloakUsersPermissionsDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceKeycloakUsersPermissionsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 
-	return keycloakClient.DisableUsersPermissions(realmId)
+	if err := keycloakClient.DisableUsersPermissions(ctx, realmId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
 
-func resourceKeycloakUsersPermissionsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakUsersPermissionsImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	d.Set("realm_id", d.Id())
 	d.SetId(d.Id())
 
@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/joed22636/terraform-provider-keycloak/keycloak"
 )
@@ -16,6 +22,17 @@ func keys(data map[string]string) []string {
 	return result
 }
 
+// Realms, user federation providers and authorization settings can take a long time to create or delete on busy
+// servers, so these resources allow their timeouts to be configured with a `timeouts` block
+func slowResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(20 * time.Minute),
+		Read:   schema.DefaultTimeout(20 * time.Minute),
+		Update: schema.DefaultTimeout(20 * time.Minute),
+		Delete: schema.DefaultTimeout(20 * time.Minute),
+	}
+}
+
 func mergeSchemas(a map[string]*schema.Schema, b map[string]*schema.Schema) map[string]*schema.Schema {
 	result := a
 	for k, v := range b {
@@ -54,7 +71,28 @@ func suppressDurationStringDiff(_, old, new string, _ *schema.ResourceData) bool
 	return oldDuration.Seconds() == newDuration.Seconds()
 }
 
-func handleNotFoundError(err error, data *schema.ResourceData) error {
+// Suppresses the diff between two JSON documents that only differ in formatting or in the order of object keys.
+// Values that aren't JSON are compared as they are.
+func suppressJsonDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeJson(old) == normalizeJson(new)
+}
+
+// Returns the compact encoding of a JSON document with sorted object keys, or the value itself if it isn't JSON
+func normalizeJson(value string) string {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	normalized, err := json.Marshal(decoded)
+	if err != nil {
+		return value
+	}
+
+	return string(normalized)
+}
+
+func handleNotFoundError(ctx context.Context, err error, data *schema.ResourceData) diag.Diagnostics {
 	if keycloak.ErrorIs404(err) {
 		log.Printf("[WARN] Removing resource with id %s from state as it no longer exists", data.Id()) // TODO: error message is misleading, it shoudl be more general, not just removal related
 		data.SetId("")
@@ -62,7 +100,75 @@ func handleNotFoundError(err error, data *schema.ResourceData) error {
 		return nil
 	}
 
-	return err
+	return diag.FromErr(err)
+}
+
+// The attributes of the struct fields the Keycloak client reports validation errors for, for resources that name their
+// attributes the same way
+var validationAttributePaths = map[string]cty.Path{
+	"AccountTheme":             cty.GetAttrPath("account_theme"),
+	"AdminPermissionsEnabled":  cty.GetAttrPath("admin_permissions_enabled"),
+	"AdminTheme":               cty.GetAttrPath("admin_theme"),
+	"Alias":                    cty.GetAttrPath("alias"),
+	"Attribute":                cty.GetAttrPath("attribute"),
+	"BindDn":                   cty.GetAttrPath("bind_dn"),
+	"Certificate":              cty.GetAttrPath("certificate"),
+	"ClientId":                 cty.GetAttrPath("client_id"),
+	"ClientScopeId":            cty.GetAttrPath("client_scope_id"),
+	"DefaultAction":            cty.GetAttrPath("default_action"),
+	"DefaultScopes":            cty.GetAttrPath("default_scopes"),
+	"DuplicateEmailsAllowed":   cty.GetAttrPath("duplicate_emails_allowed"),
+	"EmailTheme":               cty.GetAttrPath("email_theme"),
+	"Group":                    cty.GetAttrPath("group"),
+	"IncludedClientAudience":   cty.GetAttrPath("included_client_audience"),
+	"IncludedCustomAudience":   cty.GetAttrPath("included_custom_audience"),
+	"Locale":                   cty.GetAttrPath("locale"),
+	"LoginTheme":               cty.GetAttrPath("login_theme"),
+	"Name":                     cty.GetAttrPath("name"),
+	"OptionalScopes":           cty.GetAttrPath("optional_scopes"),
+	"OrganizationsEnabled":     cty.GetAttrPath("organizations_enabled"),
+	"PasswordPolicy":           cty.GetAttrPath("password_policy"),
+	"PreserveGroupInheritance": cty.GetAttrPath("preserve_group_inheritance"),
+	"PrivateKey":               cty.GetAttrPath("private_key"),
+	"Role":                     cty.GetAttrPath("role"),
+	"ServiceAccountsEnabled":   cty.GetAttrPath("service_accounts_enabled"),
+	"SslRequired":              cty.GetAttrPath("ssl_required"),
+	"WriteOnly":                cty.GetAttrPath("write_only"),
+}
+
+// Converts validation errors returned by the Keycloak client into a diagnostic pointing at the attribute that caused
+// it. The attribute is looked up in validationAttributePaths, unless the resource maps the field to a different path
+// with attributePaths. Errors for fields in neither are reported without an attribute.
+func handleValidationError(err error, attributePaths map[string]cty.Path) diag.Diagnostics {
+	var validationError *keycloak.ValidationError
+	if !errors.As(err, &validationError) {
+		return diag.FromErr(err)
+	}
+
+	attributePath, ok := attributePaths[validationError.Field]
+	if !ok {
+		attributePath = validationAttributePaths[validationError.Field]
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Error,
+			Summary:       err.Error(),
+			AttributePath: attributePath,
+		},
+	}
+}
+
+// Returns a diagnostic for a resource that Keycloak rejected because another one already uses the same value for one of
+// its unique attributes
+func conflictDiagnostic(attribute, format string, args ...interface{}) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf(format, args...),
+			AttributePath: cty.GetAttrPath(attribute),
+		},
+	}
 }
 
 func interfaceSliceToStringSlice(iv []interface{}) []string {
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak"
+	"github.com/joed22636/terraform-provider-keycloak/keycloak/keycloaktest"
+)
+
+func TestHandleValidationError(t *testing.T) {
+	err := fmt.Errorf("error creating mapper: %w", &keycloak.ValidationError{Field: "ClientScopeId", Message: "invalid"})
+
+	diags := handleValidationError(err, nil)
+	if len(diags) != 1 {
+		t.Fatalf("expected a single diagnostic, got %d", len(diags))
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("client_scope_id")) {
+		t.Errorf("expected diagnostic for client_scope_id, got %#v", diags[0].AttributePath)
+	}
+	if diags[0].Summary != err.Error() {
+		t.Errorf("expected summary %s, got %s", err.Error(), diags[0].Summary)
+	}
+}
+
+func TestHandleValidationErrorWithAttributePaths(t *testing.T) {
+	diags := handleValidationError(&keycloak.ValidationError{Field: "DefaultLocale", Message: "invalid"}, realmValidationAttributePaths)
+
+	expected := cty.GetAttrPath("internationalization").IndexInt(0).GetAttr("default_locale")
+	if !diags[0].AttributePath.Equals(expected) {
+		t.Errorf("expected diagnostic for internationalization.0.default_locale, got %#v", diags[0].AttributePath)
+	}
+}
+
+func TestHandleValidationErrorWithUnknownField(t *testing.T) {
+	// fields without a known attribute are reported for the whole resource rather than a path that doesn't exist
+	diags := handleValidationError(&keycloak.ValidationError{Field: "OTPPolicyType", Message: "invalid"}, nil)
+
+	if len(diags) != 1 || diags[0].AttributePath != nil {
+		t.Fatalf("expected a single diagnostic without attribute path, got %#v", diags)
+	}
+}
+
+func TestValidationAttributePathsCoverValidationErrors(t *testing.T) {
+	files, err := filepath.Glob("../keycloak/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fieldPattern := regexp.MustCompile(`(?:newValidationError|Pem|duplicateScopeAssignmentField =)\(?"(\w+)"`)
+	for _, file := range files {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, match := range fieldPattern.FindAllStringSubmatch(string(source), -1) {
+			field := match[1]
+			_, ok := validationAttributePaths[field]
+			_, realmOk := realmValidationAttributePaths[field]
+			_, openidClientOk := openidClientValidationAttributePaths[field]
+			if !ok && !realmOk && !openidClientOk {
+				t.Errorf("%s reports validation errors for %s, which isn't mapped to an attribute", filepath.Base(file), field)
+			}
+		}
+	}
+}
+
+func TestHandleValidationErrorWithOtherErrors(t *testing.T) {
+	diags := handleValidationError(errors.New("error sending request"), nil)
+
+	if len(diags) != 1 || diags[0].AttributePath != nil {
+		t.Fatalf("expected a single diagnostic without attribute path, got %#v", diags)
+	}
+}
+
+func TestHandleNotFoundErrorWithLoginError(t *testing.T) {
+	server := keycloaktest.NewServer()
+	defer server.Close()
+
+	// with a wrong base path, the token endpoint responds with 404 once the first request logs in
+	misconfiguredClient, err := keycloak.NewKeycloakClient(testCtx, server.URL, "/wrong", keycloaktest.ClientId, keycloaktest.ClientSecret, keycloaktest.Realm, "", "", false, 5, keycloak.TransportOptions{}, "", nil, keycloak.RetryPolicy{}, keycloak.RateLimit{}, keycloak.ClientAssertion{}, keycloak.ExternalToken{})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	realmResource := resourceKeycloakRealm()
+	data := schema.TestResourceDataRaw(t, realmResource.Schema, map[string]interface{}{
+		"realm": "existing",
+	})
+	data.SetId("existing")
+
+	diags := realmResource.ReadContext(testCtx, data, misconfiguredClient)
+	if !diags.HasError() {
+		t.Errorf("expected reading the realm to fail when the client can't log in")
+	}
+	if data.Id() != "existing" {
+		t.Errorf("expected the realm to be kept in the state when the client can't log in")
+	}
+}
+
+func TestSuppressJsonDiff(t *testing.T) {
+	tests := []struct {
+		old, new string
+		suppress bool
+	}{
+		{`{"a": 1, "b": [1, 2]}`, `{"b":[1,2],"a":1}`, true},
+		{`["a", "b"]`, `["b", "a"]`, false},
+		{`{"a": 1}`, `{"a": 2}`, false},
+		{`3`, `3`, true},
+		{`not json`, `not json`, true},
+		{`not json`, `other`, false},
+	}
+
+	for _, test := range tests {
+		if suppressJsonDiff("", test.old, test.new, nil) != test.suppress {
+			t.Errorf("expected the diff between %s and %s to be suppressed: %t", test.old, test.new, test.suppress)
+		}
+	}
+}